@@ -0,0 +1,191 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/SachinMeier/modern-art.git/game"
+	"github.com/SachinMeier/modern-art.git/game/players"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// phaseAnalysis is the bid AlphaPlayer expects for each artist after the artist counts of a first phase
+type phaseAnalysis struct {
+	Counts       map[game.Artist]int `json:"counts"`
+	ExpectedBids map[game.Artist]int `json:"expected_bids"`
+}
+
+// runAnalyze sweeps the expected bids of AlphaPlayer over every artist count of a phase up to -max,
+// or analyzes the single phase given by -counts
+func runAnalyze(args []string) error {
+	fs := flag.NewFlagSet("analyze", flag.ContinueOnError)
+	var common commonFlags
+	common.registerRules(fs)
+	common.registerFormat(fs)
+	counts := fs.String("counts", "", "comma-separated art pieces played by each artist, in the order Manuel, Sigrid, Daniel, Ramon, Rafael. Analyzes this phase only")
+	maxCount := fs.Int("max", 4, "highest count of each artist in the sweep")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := common.validateFormat(); err != nil {
+		return err
+	}
+	rules, err := common.ruleSet()
+	if err != nil {
+		return err
+	}
+
+	sweep := make([][]int, 0)
+	if *counts != "" {
+		phase, err := parseCounts(*counts)
+		if err != nil {
+			return err
+		}
+		sweep = append(sweep, phase)
+	} else {
+		sweep = countSweep(len(game.AllArtists()), *maxCount)
+	}
+
+	analyses := make([]*phaseAnalysis, 0, len(sweep))
+	for _, phase := range sweep {
+		analysis, err := analyzePhase(rules, phase)
+		if err != nil {
+			return err
+		}
+		analyses = append(analyses, analysis)
+	}
+	return writeAnalyses(os.Stdout, analyses, common.format)
+}
+
+// parseCounts parses a count for each artist
+func parseCounts(list string) ([]int, error) {
+	fields := strings.Split(list, ",")
+	if len(fields) != len(game.AllArtists()) {
+		return nil, fmt.Errorf("-counts needs a count for each of the %d artists", len(game.AllArtists()))
+	}
+	counts := make([]int, len(fields))
+	for i, field := range fields {
+		count, err := strconv.Atoi(strings.TrimSpace(field))
+		if err != nil || count < 0 {
+			return nil, fmt.Errorf("invalid count %q", field)
+		}
+		counts[i] = count
+	}
+	return counts, nil
+}
+
+// countSweep returns every combination of n counts from 0 to max
+func countSweep(n, max int) [][]int {
+	if n == 0 {
+		return [][]int{{}}
+	}
+	sweep := make([][]int, 0)
+	for count := 0; count <= max; count++ {
+		for _, rest := range countSweep(n-1, max) {
+			sweep = append(sweep, append([]int{count}, rest...))
+		}
+	}
+	return sweep
+}
+
+// analyzePhase asks a new AlphaPlayer for the expected bid of each artist in a first phase
+// in which it bought the given number of ArtPieces by each artist
+func analyzePhase(rules *game.RuleSet, counts []int) (*phaseAnalysis, error) {
+	p := players.NewAlphaPlayer("alpha-1")
+	artistCounts := make(map[game.Artist]int)
+	for i, artist := range game.AllArtists() {
+		artistCounts[artist] = counts[i]
+	}
+	view, err := newView(rules, p, nil, artistCounts)
+	if err != nil {
+		return nil, err
+	}
+	analysis := &phaseAnalysis{Counts: artistCounts, ExpectedBids: make(map[game.Artist]int)}
+	for _, artist := range game.AllArtists() {
+		analysis.ExpectedBids[artist] = p.ExpectedBid(view, artist)
+	}
+	return analysis, nil
+}
+
+// newView returns the player's GameView of a first phase in which they bought
+// the desired number of ArtPieces by each artist
+func newView(rules *game.RuleSet, p game.Player, hand []*game.ArtPiece, desiredArtistCounts map[game.Artist]int) (*game.GameView, error) {
+	phase := &game.PhaseSnapshot{
+		Auctions:     make([]*game.AuctionSnapshot, 0),
+		ArtistCounts: make(map[game.Artist]int),
+	}
+	for _, artist := range game.AllArtists() {
+		count := desiredArtistCounts[artist]
+		for i := 0; i < count; i++ {
+			phase.Auctions = append(phase.Auctions, &game.AuctionSnapshot{
+				Auctioneer: p.Name(),
+				Type:       game.AuctionTypeOpen,
+				ArtPiece:   game.NewArtPiece(artist, fmt.Sprintf("added-%s-%d", artist, i)),
+				WinningBid: &game.BidSnapshot{Bidder: p.Name(), Value: 10},
+			})
+		}
+		phase.ArtistCounts[artist] = game.Point(count)
+	}
+	g, err := game.ResumeGame(&game.Snapshot{
+		Version:      game.SnapshotVersion,
+		CurrentPhase: game.Phase1,
+		Phase:        phase,
+		Players: []*game.PlayerSnapshot{{
+			Name:  p.Name(),
+			Hand:  hand,
+			Money: rules.StartingMoney,
+		}},
+		Rules: rules,
+	}, []game.Player{p})
+	if err != nil {
+		return nil, err
+	}
+	return g.View(p.Name()), nil
+}
+
+// writeAnalyses writes the analyses in the format. CSV and text have a column for the count and the
+// expected bid of each artist.
+func writeAnalyses(w io.Writer, analyses []*phaseAnalysis, format string) error {
+	if format == formatJSON {
+		return writeJSON(w, analyses)
+	}
+	header := make([]string, 0)
+	for _, artist := range game.AllArtists() {
+		header = append(header, artistKey(artist))
+	}
+	for _, artist := range game.AllArtists() {
+		header = append(header, artistKey(artist)+"-bid")
+	}
+	rows := [][]string{header}
+	for _, analysis := range analyses {
+		row := make([]string, 0, len(header))
+		for _, artist := range game.AllArtists() {
+			row = append(row, strconv.Itoa(analysis.Counts[artist]))
+		}
+		for _, artist := range game.AllArtists() {
+			row = append(row, strconv.Itoa(analysis.ExpectedBids[artist]))
+		}
+		rows = append(rows, row)
+	}
+	if format == formatCSV {
+		return writeCSV(w, rows)
+	}
+	for _, row := range rows {
+		for _, cell := range row {
+			if _, err := fmt.Fprintf(w, "%-12s", cell); err != nil {
+				return err
+			}
+		}
+		if _, err := fmt.Fprintln(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// artistKey is the artist's first name in lower case
+func artistKey(artist game.Artist) string {
+	return strings.ToLower(strings.Fields(string(artist))[0])
+}
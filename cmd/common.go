@@ -0,0 +1,191 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"github.com/SachinMeier/modern-art.git/game"
+	"github.com/SachinMeier/modern-art.git/game/players"
+	"io"
+	"math/rand"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Output formats
+const (
+	formatText = "text"
+	formatJSON = "json"
+	formatCSV  = "csv"
+)
+
+// playerKinds are the kinds of Players that can take a seat, by the name used on the command line
+var playerKinds = map[string]func(name string) game.Player{
+	"human":   func(name string) game.Player { return players.NewIOPlayer(name) },
+	"advisor": func(name string) game.Player { return players.NewAdvisorPlayer(players.NewIOPlayer(name)) },
+	"alpha":   func(name string) game.Player { return players.NewAlphaPlayer(name) },
+	"dummy":   func(name string) game.Player { return players.NewDummyPlayer(name) },
+}
+
+// humanKinds are the kinds of Players that are played by a person at the terminal
+var humanKinds = map[string]bool{
+	"human":   true,
+	"advisor": true,
+}
+
+// commonFlags are the flags shared by the subcommands
+type commonFlags struct {
+	seed   int64
+	rules  string
+	format string
+}
+
+// registerSeed adds the -seed flag to the FlagSet
+func (f *commonFlags) registerSeed(fs *flag.FlagSet) {
+	fs.Int64Var(&f.seed, "seed", 0, "seed of the game, or of the first game. 0 picks a random seed")
+}
+
+// registerRules adds the -rules flag to the FlagSet
+func (f *commonFlags) registerRules(fs *flag.FlagSet) {
+	fs.StringVar(&f.rules, "rules", "", "JSON or YAML rules file. The official rules are used if it is empty")
+}
+
+// registerFormat adds the -format flag to the FlagSet
+func (f *commonFlags) registerFormat(fs *flag.FlagSet) {
+	fs.StringVar(&f.format, "format", formatText, "output format: text, json or csv")
+}
+
+// registerBots adds the repeatable -bot flag, which adds a kind of player run by a command as a child process
+func (f *commonFlags) registerBots(fs *flag.FlagSet) {
+	fs.Func("bot", "kind=command: adds a kind of player that runs the command and plays over the process protocol. Can be repeated", addBotKind)
+}
+
+// addBotKind adds the kind of player of a -bot flag. Each player of the kind runs its own bot.
+func addBotKind(value string) error {
+	kind, command, _ := strings.Cut(value, "=")
+	kind = strings.TrimSpace(kind)
+	fields := strings.Fields(command)
+	if kind == "" || len(fields) == 0 {
+		return fmt.Errorf("want kind=command, got %q", value)
+	}
+	if _, ok := playerKinds[kind]; ok {
+		return fmt.Errorf("player kind %q is already taken", kind)
+	}
+	playerKinds[kind] = func(name string) game.Player {
+		p := players.NewProcessPlayer(name, fields[0], fields[1:]...)
+		p.SetStderr(os.Stderr)
+		return p
+	}
+	return nil
+}
+
+// validateFormat checks the output format
+func (f *commonFlags) validateFormat() error {
+	switch f.format {
+	case formatText, formatJSON, formatCSV:
+		return nil
+	default:
+		return fmt.Errorf("unknown format %q, want text, json or csv", f.format)
+	}
+}
+
+// ruleSet loads the rules file, or returns the official rules
+func (f *commonFlags) ruleSet() (*game.RuleSet, error) {
+	if f.rules == "" {
+		return game.DefaultRuleSet(), nil
+	}
+	return game.LoadRuleSet(f.rules)
+}
+
+// parseKinds splits a comma-separated list of player kinds and checks that they exist
+func parseKinds(list string) ([]string, error) {
+	kinds := strings.Split(list, ",")
+	for i, kind := range kinds {
+		kinds[i] = strings.TrimSpace(kind)
+		if _, ok := playerKinds[kinds[i]]; !ok {
+			return nil, fmt.Errorf("unknown player kind %q, want human, advisor, alpha, dummy or a kind added with -bot", kinds[i])
+		}
+	}
+	return kinds, nil
+}
+
+// newPlayers creates a Player of each kind, named by kind and seat
+func newPlayers(kinds []string) []game.Player {
+	ps := make([]game.Player, len(kinds))
+	for i, kind := range kinds {
+		ps[i] = playerKinds[kind](fmt.Sprintf("%s-%d", kind, i))
+	}
+	return ps
+}
+
+// closePlayers closes the Players that run bots once their Game is over
+func closePlayers(ps []game.Player) {
+	for _, p := range ps {
+		if closer, ok := p.(io.Closer); ok {
+			_ = closer.Close()
+		}
+	}
+}
+
+// writeJSON writes the value as indented JSON
+func writeJSON(w io.Writer, v any) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
+
+// writeCSV writes the rows as CSV
+func writeCSV(w io.Writer, rows [][]string) error {
+	writer := csv.NewWriter(w)
+	if err := writer.WriteAll(rows); err != nil {
+		return err
+	}
+	return writer.Error()
+}
+
+// writeStandings writes the Standings of a GameResult in the format
+func writeStandings(w io.Writer, result *game.GameResult, format string) error {
+	switch format {
+	case formatJSON:
+		return writeJSON(w, result)
+	case formatCSV:
+		rows := [][]string{{"place", "player", "money", "tied"}}
+		for _, standing := range result.Standings {
+			rows = append(rows, []string{
+				strconv.Itoa(standing.Place), standing.Player, strconv.Itoa(standing.Money), strconv.FormatBool(standing.Tied),
+			})
+		}
+		return writeCSV(w, rows)
+	default:
+		for _, standing := range result.Standings {
+			tied := ""
+			if standing.Tied {
+				tied = " (tied)"
+			}
+			if _, err := fmt.Fprintf(w, "%d. %-12s %4d%s\n", standing.Place, standing.Player, standing.Money, tied); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// checkBots returns an error if a kind is a human, who cannot play many games in a row
+func checkBots(kinds []string) error {
+	for _, kind := range kinds {
+		if humanKinds[kind] {
+			return fmt.Errorf("%s players can only play single games", kind)
+		}
+	}
+	return nil
+}
+
+// seedOrRandom returns the seed, or a random one if it is 0
+func seedOrRandom(seed int64) int64 {
+	for seed == 0 {
+		seed = rand.Int63()
+	}
+	return seed
+}
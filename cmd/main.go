@@ -1,144 +1,50 @@
 package main
 
 import (
-	"encoding/csv"
+	"errors"
+	"flag"
 	"fmt"
-	"github.com/SachinMeier/modern-art.git/game"
-	"github.com/SachinMeier/modern-art.git/game/players"
-	"log"
 	"os"
 )
 
-func main() {
-	simulateAllPossibleCurrentPhases()
-	//simulateSinglePhase(0, 0, 0, 0, 0)
-	//runManualGame()
-}
-
-func runManualGame() {
-	p1 := players.NewIOPlayer("me")
-	g := game.NewGame([]game.Player{p1})
-	g.Start()
-}
-
-// NewPhase creates a new phase with the given artist counts
-func newPhase(manuel, sigrid, daniel, ramon, rafael int) *game.Phase {
-	return &game.Phase{
-		ArtistCounts: map[game.Artist]int{
-			game.Manuel: game.Point(manuel),
-			game.Sigrid: game.Point(sigrid),
-			game.Daniel: game.Point(daniel),
-			game.Ramon:  game.Point(ramon),
-			game.Rafael: game.Point(rafael),
-		},
-	}
+const usage = `usage: modern-art <command> [flags]
+
+commands:
+  play        play a game with a lineup of human and bot seats
+  simulate    play many games of bots with the same lineup
+  tournament  play every lineup with its seats rotated and rate the bots
+  replay      replay a recorded game and check it against the engine
+  analyze     sweep the bids AlphaPlayer expects over the artist counts of a phase
+  serve       host games in rooms that players join from a browser or over WebSocket
+
+Run "modern-art <command> -h" for the flags of a command.
+`
+
+// commands are the subcommands by name
+var commands = map[string]func(args []string) error{
+	"play":       runPlay,
+	"simulate":   runSimulate,
+	"tournament": runTournament,
+	"replay":     runReplay,
+	"analyze":    runAnalyze,
+	"serve":      runServe,
 }
 
-func addAuctions(p game.Player, desiredArtistCounts map[game.Artist]int) {
-	for artist, count := range desiredArtistCounts {
-		for i := 0; i < count; i++ {
-			p.HandleAuctionResult(&game.Auction{
-				Auctioneer: p,
-				WinningBid: game.NewBid(p, 10),
-				ArtPiece:   game.NewArtPiece(artist, fmt.Sprintf("added-manuel-%d", i)),
-			})
-		}
-	}
-}
-
-func simulateSinglePhase(manuel, sigrid, daniel, ramon, rafael int) {
-	p1 := players.NewAlphaPlayer("alpha-1")
-	//p2 := players.NewAlphaPlayer("alpha-2")
-	hand := []*game.ArtPiece{
-		game.NewArtPiece(game.Manuel, "manuel-1"),
-		game.NewArtPiece(game.Manuel, "manuel-2"),
-		game.NewArtPiece(game.Sigrid, "sigrid-1"),
-		game.NewArtPiece(game.Daniel, "daniel-1"),
-		game.NewArtPiece(game.Ramon, "ramon-2"),
-		game.NewArtPiece(game.Rafael, "rafael-1"),
-	}
-
-	// 1 of each artist type
-	addAuctions(p1, map[game.Artist]int{
-		game.Manuel: manuel,
-		game.Sigrid: sigrid,
-		game.Daniel: daniel,
-		game.Ramon:  ramon,
-		game.Rafael: rafael,
-	})
-
-	p1.AddArtPieces(hand)
-	for _, artist := range game.AllArtists() {
-		bid := p1.ExpectedBid(artist)
-		log.Printf("expected bid for %s: %d\n", artist, bid)
-	}
-}
-
-func simulateAllPossibleCurrentPhases() {
-	// Create a new CSV file
-	file, err := os.Create("scenarios.csv")
-	if err != nil {
-		panic(err)
-	}
-	defer file.Close()
-
-	// Create a CSV writer
-	writer := csv.NewWriter(file)
-	defer writer.Flush()
-
-	headers := []string{
-		"manuel", "sigrid", "daniel", "ramon", "rafael", "manuel-bid", "sigrid-bid", "daniel-bid", "ramon-bid", "rafael-bid",
-	}
-	if err := writer.Write(headers); err != nil {
-		panic(err)
-	}
-
-	possiblePieceCts := []int{0, 1, 2, 3, 4}
-	for _, manuel := range possiblePieceCts {
-		for _, sigrid := range possiblePieceCts {
-			for _, daniel := range possiblePieceCts {
-				for _, ramon := range possiblePieceCts {
-					for _, rafael := range possiblePieceCts {
-						p := players.NewAlphaPlayer("alpha-1")
-						addAuctions(p, map[game.Artist]int{
-							game.Manuel: manuel,
-							game.Sigrid: sigrid,
-							game.Daniel: daniel,
-							game.Ramon:  ramon,
-							game.Rafael: rafael,
-						})
-
-						strManuel := fmt.Sprintf("%d", manuel)
-						strSigrid := fmt.Sprintf("%d", sigrid)
-						strDaniel := fmt.Sprintf("%d", daniel)
-						strRamon := fmt.Sprintf("%d", ramon)
-						strRafael := fmt.Sprintf("%d", rafael)
-
-						bidManuel := fmt.Sprintf("%d", p.ExpectedBid(game.Manuel))
-						bidSigrid := fmt.Sprintf("%d", p.ExpectedBid(game.Sigrid))
-						bidDaniel := fmt.Sprintf("%d", p.ExpectedBid(game.Daniel))
-						bidRamon := fmt.Sprintf("%d", p.ExpectedBid(game.Ramon))
-						bidRafael := fmt.Sprintf("%d", p.ExpectedBid(game.Rafael))
-
-						// write to csv
-						row := []string{
-							strManuel, strSigrid, strDaniel, strRamon, strRafael,
-							bidManuel, bidSigrid, bidDaniel, bidRamon, bidRafael,
-						}
-						if err := writer.Write(row); err != nil {
-							panic(err)
-						}
-					}
-				}
-			}
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	run, ok := commands[os.Args[1]]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "unknown command %q\n\n%s", os.Args[1], usage)
+		os.Exit(2)
+	}
+	if err := run(os.Args[2:]); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return
 		}
-	}
-
-	// Flush any remaining data in the buffer
-	writer.Flush()
-
-	// Check for errors during writing
-	if err := writer.Error(); err != nil {
-		panic(err)
+		fmt.Fprintf(os.Stderr, "%s: %s\n", os.Args[1], err)
+		os.Exit(1)
 	}
 }
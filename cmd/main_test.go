@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"github.com/stretchr/testify/suite"
+	"os"
+	"strconv"
+	"testing"
+)
+
+func TestCommandSuite(t *testing.T) {
+	suite.Run(t, new(CommandTestSuite))
+}
+
+type CommandTestSuite struct {
+	suite.Suite
+	testCtx    context.Context
+	cancelFunc context.CancelFunc
+	stdout     *os.File
+}
+
+func (suite *CommandTestSuite) SetupSuite() {}
+
+// SetupTest discards what the commands write to stdout
+func (suite *CommandTestSuite) SetupTest() {
+	suite.testCtx, suite.cancelFunc = context.WithCancel(context.Background())
+	devNull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	suite.Require().NoError(err)
+	suite.stdout, os.Stdout = os.Stdout, devNull
+}
+
+func (suite *CommandTestSuite) TearDownTest() {
+	suite.cancelFunc()
+	_ = os.Stdout.Close()
+	os.Stdout = suite.stdout
+}
+
+func (suite *CommandTestSuite) TearDownSuite() {}
+
+func (suite *CommandTestSuite) Test_Defaults() {
+	// 1. Test that simulate plays its default lineup over several seeds
+	{
+		for seed := 1; seed <= 5; seed++ {
+			suite.NoError(runSimulate([]string{"-seed", strconv.Itoa(seed)}), "seed %d", seed)
+		}
+	}
+
+	// 2. Test that tournament plays its default lineups over several seeds
+	{
+		for seed := 1; seed <= 5; seed++ {
+			suite.NoError(runTournament([]string{"-seed", strconv.Itoa(seed)}), "seed %d", seed)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/SachinMeier/modern-art.git/game"
+	"github.com/SachinMeier/modern-art.git/game/players"
+	"os"
+)
+
+// runPlay plays one Game with a lineup of human and bot seats and writes the final standings
+func runPlay(args []string) error {
+	fs := flag.NewFlagSet("play", flag.ContinueOnError)
+	var common commonFlags
+	common.registerSeed(fs)
+	common.registerRules(fs)
+	common.registerFormat(fs)
+	common.registerBots(fs)
+	lineup := fs.String("players", "human,alpha,alpha", "comma-separated kinds of the players in seat order: human, advisor, alpha, dummy or a -bot kind")
+	record := fs.String("record", "", "file to write the event log of the game to as JSON Lines")
+	overrides := fs.String("overrides", "", "file to write the suggestions advisor players override to as JSON Lines")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := common.validateFormat(); err != nil {
+		return err
+	}
+	kinds, err := parseKinds(*lineup)
+	if err != nil {
+		return err
+	}
+	rules, err := common.ruleSet()
+	if err != nil {
+		return err
+	}
+
+	opts := []game.GameOption{game.WithRuleSet(rules), game.WithSeed(seedOrRandom(common.seed))}
+	if *record != "" {
+		file, err := os.Create(*record)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		opts = append(opts, game.WithEventListener(game.JSONLinesListener(file)))
+	}
+	ps := newPlayers(kinds)
+	defer closePlayers(ps)
+	if *overrides != "" {
+		file, err := os.Create(*overrides)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		for _, p := range ps {
+			if advisor, ok := p.(*players.AdvisorPlayer); ok {
+				advisor.SetOverrideLog(file)
+			}
+		}
+	}
+	g, err := game.NewGame(ps, opts...)
+	if err != nil {
+		return err
+	}
+	if common.format == formatText {
+		fmt.Printf("seed %d\n", g.Seed())
+	}
+	result, err := g.Start()
+	if err != nil {
+		return err
+	}
+	return writeStandings(os.Stdout, result, common.format)
+}
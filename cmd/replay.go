@@ -0,0 +1,47 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/SachinMeier/modern-art.git/game"
+	"os"
+)
+
+// runReplay replays a Game recorded as JSON Lines, checks it against the engine and writes its final standings
+func runReplay(args []string) error {
+	fs := flag.NewFlagSet("replay", flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: modern-art replay [flags] <record.jsonl>")
+		fs.PrintDefaults()
+	}
+	var common commonFlags
+	common.registerFormat(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := common.validateFormat(); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return fmt.Errorf("replay needs one record file")
+	}
+
+	file, err := os.Open(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	record, err := game.ReadJSONLines(file)
+	if err != nil {
+		return err
+	}
+	g, err := game.Replay(record)
+	if err != nil {
+		return err
+	}
+	if common.format == formatText {
+		fmt.Printf("replayed %d events of seed %d without divergence\n", len(g.Events()), g.Seed())
+	}
+	return writeStandings(os.Stdout, g.Result(), common.format)
+}
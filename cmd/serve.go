@@ -0,0 +1,33 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/SachinMeier/modern-art.git/game"
+	"github.com/SachinMeier/modern-art.git/game/server"
+	"net/http"
+	"os"
+)
+
+// runServe hosts Games in rooms that players join over WebSocket
+func runServe(args []string) error {
+	fs := flag.NewFlagSet("serve", flag.ContinueOnError)
+	var common commonFlags
+	common.registerBots(fs)
+	addr := fs.String("addr", ":8080", "address to listen on")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	// every kind of bot can take a seat in a room
+	bots := make(map[string]func(name string) game.Player)
+	for kind, newPlayer := range playerKinds {
+		if !humanKinds[kind] {
+			bots[kind] = newPlayer
+		}
+	}
+	srv := server.NewServer(bots)
+	defer srv.Close()
+	fmt.Fprintf(os.Stderr, "listening on %s\n", *addr)
+	return http.ListenAndServe(*addr, srv)
+}
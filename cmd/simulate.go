@@ -0,0 +1,130 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"github.com/SachinMeier/modern-art.git/game"
+	"github.com/SachinMeier/modern-art.git/game/simulation"
+	"io"
+	"os"
+	"strconv"
+)
+
+// simulationSummary is the outcome of a simulation, by seat
+type simulationSummary struct {
+	Seed    int64          `json:"seed"`
+	Games   int            `json:"games"`
+	Players []*seatSummary `json:"players"`
+}
+
+// seatSummary is the outcome of the Player in one seat over every Game of a simulation
+type seatSummary struct {
+	Player string `json:"player"`
+	// Wins counts a first place shared by k players as 1/k of a win
+	Wins         float64 `json:"wins"`
+	WinRate      float64 `json:"win_rate"`
+	AverageMoney float64 `json:"average_money"`
+}
+
+// runSimulate plays many Games of bots with the same lineup, with seeds counting up from the seed
+func runSimulate(args []string) error {
+	fs := flag.NewFlagSet("simulate", flag.ContinueOnError)
+	var common commonFlags
+	common.registerSeed(fs)
+	common.registerRules(fs)
+	common.registerFormat(fs)
+	common.registerBots(fs)
+	lineup := fs.String("players", "alpha,dummy,dummy", "comma-separated kinds of the players in seat order: alpha, dummy or a -bot kind")
+	games := fs.Int("games", 100, "number of games to play")
+	workers := fs.Int("workers", 0, "number of games played at once. 0 plays one per CPU")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := common.validateFormat(); err != nil {
+		return err
+	}
+	kinds, err := parseKinds(*lineup)
+	if err != nil {
+		return err
+	}
+	if err := checkBots(kinds); err != nil {
+		return err
+	}
+	if *games < 1 {
+		return fmt.Errorf("-games must be at least 1")
+	}
+	rules, err := common.ruleSet()
+	if err != nil {
+		return err
+	}
+
+	seed := seedOrRandom(common.seed)
+	jobs := make([]*simulation.Job, *games)
+	for i := range jobs {
+		jobs[i] = &simulation.Job{
+			ID:         i,
+			NewPlayers: func() []game.Player { return newPlayers(kinds) },
+			Options:    []game.GameOption{game.WithRuleSet(rules), game.WithSeed(seed + int64(i))},
+		}
+	}
+	summary := &simulationSummary{Seed: seed, Games: *games, Players: make([]*seatSummary, 0, len(kinds))}
+	for _, player := range newPlayers(kinds) {
+		summary.Players = append(summary.Players, &seatSummary{Player: player.Name()})
+	}
+	for _, outcome := range simulation.NewSimulator(*workers).RunAll(context.Background(), jobs) {
+		if outcome.Err != nil {
+			return fmt.Errorf("seed %d: %w", seed+int64(outcome.ID), outcome.Err)
+		}
+		summary.add(outcome.Result)
+	}
+	for _, seat := range summary.Players {
+		seat.WinRate = seat.Wins / float64(summary.Games)
+		seat.AverageMoney /= float64(summary.Games)
+	}
+	return summary.write(os.Stdout, common.format)
+}
+
+// add adds the wins and money of a Game. The money is summed until all Games are added.
+func (s *simulationSummary) add(result *game.GameResult) {
+	winners := result.Winners()
+	scores := result.Scores()
+	for _, seat := range s.Players {
+		for _, winner := range winners {
+			if winner == seat.Player {
+				seat.Wins += 1 / float64(len(winners))
+			}
+		}
+		seat.AverageMoney += float64(scores[seat.Player])
+	}
+}
+
+// write writes the summary in the format
+func (s *simulationSummary) write(w io.Writer, format string) error {
+	switch format {
+	case formatJSON:
+		return writeJSON(w, s)
+	case formatCSV:
+		rows := [][]string{{"player", "games", "wins", "win_rate", "average_money"}}
+		for _, seat := range s.Players {
+			rows = append(rows, []string{
+				seat.Player,
+				strconv.Itoa(s.Games),
+				strconv.FormatFloat(seat.Wins, 'f', 2, 64),
+				strconv.FormatFloat(seat.WinRate, 'f', 4, 64),
+				strconv.FormatFloat(seat.AverageMoney, 'f', 2, 64),
+			})
+		}
+		return writeCSV(w, rows)
+	default:
+		if _, err := fmt.Fprintf(w, "%d games from seed %d\n", s.Games, s.Seed); err != nil {
+			return err
+		}
+		for _, seat := range s.Players {
+			if _, err := fmt.Fprintf(w, "%-12s win rate %5.1f%%  average money %7.2f\n", seat.Player, 100*seat.WinRate, seat.AverageMoney); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/SachinMeier/modern-art.git/game/tournament"
+	"io"
+	"os"
+	"strings"
+)
+
+// runTournament plays every lineup with each rotation of its seats and rates the kinds of bots
+func runTournament(args []string) error {
+	fs := flag.NewFlagSet("tournament", flag.ContinueOnError)
+	var common commonFlags
+	common.registerSeed(fs)
+	common.registerRules(fs)
+	common.registerFormat(fs)
+	common.registerBots(fs)
+	lineups := fs.String("lineups", "alpha,dummy,dummy", "semicolon-separated lineups, each a comma-separated list of kinds in seat order")
+	games := fs.Int("games", 10, "number of games played for each rotation of each lineup")
+	workers := fs.Int("workers", 0, "number of games played at once. 0 plays one per CPU")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := common.validateFormat(); err != nil {
+		return err
+	}
+	rules, err := common.ruleSet()
+	if err != nil {
+		return err
+	}
+
+	config := &tournament.Config{
+		Games:   *games,
+		Seed:    seedOrRandom(common.seed),
+		Rules:   rules,
+		Workers: *workers,
+	}
+	// every kind of bot in the lineups is an Entrant
+	entrants := make(map[string]bool)
+	for _, lineup := range strings.Split(*lineups, ";") {
+		kinds, err := parseKinds(lineup)
+		if err != nil {
+			return err
+		}
+		if err := checkBots(kinds); err != nil {
+			return err
+		}
+		for _, kind := range kinds {
+			if !entrants[kind] {
+				entrants[kind] = true
+				config.Entrants = append(config.Entrants, tournament.Entrant{Name: kind, New: playerKinds[kind]})
+			}
+		}
+		config.Lineups = append(config.Lineups, kinds)
+	}
+
+	result, err := tournament.Run(config)
+	if err != nil {
+		return err
+	}
+	switch common.format {
+	case formatJSON:
+		return tournament.WriteJSON(os.Stdout, result)
+	case formatCSV:
+		return tournament.WriteCSV(os.Stdout, result)
+	default:
+		return writeTournamentTable(os.Stdout, config, result)
+	}
+}
+
+// writeTournamentTable writes the Standings of the Result as a table
+func writeTournamentTable(w io.Writer, config *tournament.Config, result *tournament.Result) error {
+	if _, err := fmt.Fprintf(w, "%d games from seed %d\n", len(result.Games), config.Seed); err != nil {
+		return err
+	}
+	for _, stats := range result.Standings {
+		_, err := fmt.Fprintf(w, "%-8s rating %7.1f  win rate %5.1f%% [%5.1f%%, %5.1f%%]  average money %6.1f [%6.1f, %6.1f]\n",
+			stats.Entrant, stats.Rating, 100*stats.WinRate, 100*stats.WinRateLow, 100*stats.WinRateHigh,
+			stats.AverageMoney, stats.MoneyLow, stats.MoneyHigh)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
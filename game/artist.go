@@ -2,6 +2,7 @@ package game
 
 import (
 	"fmt"
+	"math/rand"
 )
 
 // In order to track points and also allow for tie breakers,
@@ -54,28 +55,57 @@ func ArtistArtCounts() map[Artist]int {
 	}
 }
 
-// AddTieBreakers adds tiebreaker points to the map of artists.
-// Since Artist values are stored as 10 points per ArtPiece in the round,
-// the tiebreaker points can never mess up the order.
-func AddTieBreakers(artists map[Artist]int) map[Artist]int {
-	// return a deep copy to avoid messing with Phase state
-	// and allow Phase.RankedArtists() to be called multiple times
-	return map[Artist]int{
-		Manuel: artists[Manuel] + Tiebreakers[Manuel],
-		Sigrid: artists[Sigrid] + Tiebreakers[Sigrid],
-		Daniel: artists[Daniel] + Tiebreakers[Daniel],
-		Ramon:  artists[Ramon] + Tiebreakers[Ramon],
-		Rafael: artists[Rafael] + Tiebreakers[Rafael],
+// ArtistAuctionTypeCounts returns a map of artists to the number of art pieces
+// of each AuctionType they have, following the official deck composition.
+// The counts for each artist sum to their count in ArtistArtCounts.
+func ArtistAuctionTypeCounts() map[Artist]map[AuctionType]int {
+	return map[Artist]map[AuctionType]int{
+		Manuel: {
+			AuctionTypeOpen:     3,
+			AuctionTypeOneShot:  3,
+			AuctionTypeBlind:    2,
+			AuctionTypeSetPrice: 2,
+			AuctionTypeDouble:   2,
+		},
+		Sigrid: {
+			AuctionTypeOpen:     3,
+			AuctionTypeOneShot:  2,
+			AuctionTypeBlind:    3,
+			AuctionTypeSetPrice: 3,
+			AuctionTypeDouble:   2,
+		},
+		Daniel: {
+			AuctionTypeOpen:     3,
+			AuctionTypeOneShot:  3,
+			AuctionTypeBlind:    3,
+			AuctionTypeSetPrice: 3,
+			AuctionTypeDouble:   2,
+		},
+		Ramon: {
+			AuctionTypeOpen:     3,
+			AuctionTypeOneShot:  3,
+			AuctionTypeBlind:    3,
+			AuctionTypeSetPrice: 3,
+			AuctionTypeDouble:   3,
+		},
+		Rafael: {
+			AuctionTypeOpen:     4,
+			AuctionTypeOneShot:  3,
+			AuctionTypeBlind:    3,
+			AuctionTypeSetPrice: 3,
+			AuctionTypeDouble:   3,
+		},
 	}
 }
 
 // ArtPiece is a piece of art, which hails from an Artist.
 type ArtPiece struct {
 	// Name is an arbitrary name to id the art. necessary?
-	Name string
+	Name string `json:"name"`
 	// Artist is the artist who created the art
-	Artist Artist
-	// AuctionType
+	Artist Artist `json:"artist"`
+	// AuctionType is the type of Auction the ArtPiece is sold with
+	AuctionType AuctionType `json:"auction_type"`
 }
 
 // NewArtPiece returns a new ArtPiece with the given name and artist.
@@ -86,27 +116,49 @@ func NewArtPiece(artist Artist, name string) *ArtPiece {
 	}
 }
 
+// NewArtPieceWithAuctionType returns a new ArtPiece with the given name, artist and AuctionType.
+func NewArtPieceWithAuctionType(artist Artist, name string, auctionType AuctionType) *ArtPiece {
+	return &ArtPiece{
+		Name:        name,
+		Artist:      artist,
+		AuctionType: auctionType,
+	}
+}
+
+// IsDouble returns true if the ArtPiece is a double (2x) card, which is auctioned
+// together with a second ArtPiece of the same Artist.
+func (ap *ArtPiece) IsDouble() bool {
+	return ap.AuctionType == AuctionTypeDouble
+}
+
 // NewArtPieceDeck returns a slice of all ArtPieces in the game.
 // Consider the implementation here. It could be done more lazily
 // by tracking count of remaining pieces instead of instantiating
 // all of them at once.
+// The deck is always built in the same order so that dealing only
+// depends on the randomness used to pick ArtPieces.
 func NewArtPieceDeck() []*ArtPiece {
 	deck := []*ArtPiece{}
-	for artist, count := range ArtistArtCounts() {
-		for i := 0; i < count; i++ {
-			deck = append(deck, &ArtPiece{
-				Name:   fmt.Sprintf("%s-%d", string(artist), i),
-				Artist: artist,
-			})
+	auctionTypeCounts := ArtistAuctionTypeCounts()
+	for _, artist := range AllArtists() {
+		i := 0
+		for _, auctionType := range AllAuctionTypes() {
+			for j := 0; j < auctionTypeCounts[artist][auctionType]; j++ {
+				deck = append(deck, &ArtPiece{
+					Name:        fmt.Sprintf("%s-%d", string(artist), i),
+					Artist:      artist,
+					AuctionType: auctionType,
+				})
+				i++
+			}
 		}
 	}
 	return deck
 }
 
-func pickRandomArtPiece(deck []*ArtPiece) (*ArtPiece, []*ArtPiece) {
-	idx, err := randInt(len(deck))
-	if err != nil {
-		panic(err)
-	}
-	return deck[idx], append(deck[:idx], deck[idx+1:]...)
+func pickRandomArtPiece(rng *rand.Rand, deck []*ArtPiece) (*ArtPiece, []*ArtPiece) {
+	idx := rng.Intn(len(deck))
+	// the ArtPiece must be read before append shifts the rest of the deck over it
+	artPiece := deck[idx]
+	return artPiece, append(deck[:idx], deck[idx+1:]...)
 }
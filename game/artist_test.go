@@ -0,0 +1,70 @@
+package game_test
+
+import (
+	"context"
+	"github.com/SachinMeier/modern-art.git/game"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+func TestArtistSuite(t *testing.T) {
+	suite.Run(t, new(ArtistTestSuite))
+}
+
+type ArtistTestSuite struct {
+	suite.Suite
+	testCtx    context.Context
+	cancelFunc context.CancelFunc
+}
+
+func (suite *ArtistTestSuite) SetupSuite() {}
+
+func (suite *ArtistTestSuite) SetupTest() {
+	suite.testCtx, suite.cancelFunc = context.WithCancel(context.Background())
+}
+
+func (suite *ArtistTestSuite) TearDownTest() {
+	suite.cancelFunc()
+}
+
+func (suite *ArtistTestSuite) TearDownSuite() {}
+
+func (suite *ArtistTestSuite) Test_ArtistAuctionTypeCounts() {
+	// 1. Test that the AuctionType counts add up to the ArtPiece counts
+	{
+		artCounts := game.ArtistArtCounts()
+		for artist, auctionTypeCounts := range game.ArtistAuctionTypeCounts() {
+			sum := 0
+			for _, count := range auctionTypeCounts {
+				sum += count
+			}
+			suite.Equal(artCounts[artist], sum, "wrong count for %s", artist)
+		}
+	}
+}
+
+func (suite *ArtistTestSuite) Test_NewArtPieceDeck() {
+	// 1. Test that the deck follows the official composition
+	{
+		deck := game.NewArtPieceDeck()
+		counts := make(map[game.Artist]map[game.AuctionType]int)
+		for _, artPiece := range deck {
+			if _, ok := counts[artPiece.Artist]; !ok {
+				counts[artPiece.Artist] = make(map[game.AuctionType]int)
+			}
+			counts[artPiece.Artist][artPiece.AuctionType]++
+		}
+		suite.Equal(game.ArtistAuctionTypeCounts(), counts)
+	}
+
+	// 2. Test that the deck is built in the same order every time
+	{
+		deck1 := game.NewArtPieceDeck()
+		deck2 := game.NewArtPieceDeck()
+		suite.Equal(len(deck1), len(deck2))
+		for i := range deck1 {
+			mustMatchArtPiece(&suite.Suite, deck1[i], deck2[i])
+			suite.Equal(deck1[i].AuctionType, deck2[i].AuctionType)
+		}
+	}
+}
@@ -1,9 +1,6 @@
 package game
 
-import (
-	"fmt"
-	"sync"
-)
+import "fmt"
 
 // Auction is an auction for an ArtPiece
 type Auction struct {
@@ -14,37 +11,93 @@ type Auction struct {
 	// TODO: add incremental id
 	// ArtPiece is the piece being auctioned
 	ArtPiece *ArtPiece
+	// SecondArtPiece is the piece auctioned alongside a double ArtPiece.
+	// It is nil for all other Auctions.
+	SecondArtPiece *ArtPiece
 	// WinningBid is the winning Bid for the Auction
 	WinningBid *Bid
+	// BlindBids are all the Bids of a blind Auction in the order they were placed. They are set once the
+	// Auction is over, and Players only see them if the rules reveal blind bids.
+	BlindBids []*Bid
 }
 
-// NewAuction creates a new Auction
+// NewAuction creates a new Auction with the AuctionType of the ArtPiece
 func NewAuction(auctioneer Player, artPiece *ArtPiece, winningBid *Bid) *Auction {
 	return &Auction{
 		Auctioneer: auctioneer,
+		Type:       artPiece.AuctionType,
 		ArtPiece:   artPiece,
 		WinningBid: winningBid,
 	}
 }
 
-// Run runs an Auction by requesting a single bid from each bidder.
-// TODO: later, this is where we will add support for different AuctionType's
-func (a *Auction) Run(bidders []*GamePlayer) {
+// IsDouble returns true if the Auction was started with a double ArtPiece
+func (a *Auction) IsDouble() bool {
+	return a.ArtPiece.IsDouble()
+}
+
+// ArtPieces returns all ArtPieces sold in the Auction
+func (a *Auction) ArtPieces() []*ArtPiece {
+	if a.SecondArtPiece != nil {
+		return []*ArtPiece{a.ArtPiece, a.SecondArtPiece}
+	}
+	return []*ArtPiece{a.ArtPiece}
+}
+
+// copy returns a shallow copy of the Auction
+func (a *Auction) copy() *Auction {
+	auction := *a
+	return &auction
+}
+
+// ArtPieceAuctionType returns the AuctionType dictated by the Auction's ArtPieces.
+// A double Auction is run with the AuctionType of its SecondArtPiece.
+func (a *Auction) ArtPieceAuctionType() AuctionType {
+	if a.SecondArtPiece != nil {
+		return a.SecondArtPiece.AuctionType
+	}
+	return a.ArtPiece.AuctionType
+}
+
+// validateAuctionType returns an error if the Auction's Type disagrees with its ArtPieces.
+// An empty Type is allowed and means the Player left it to the Game.
+func validateAuctionType(auction *Auction) error {
+	switch auction.Type {
+	case "", auction.ArtPieceAuctionType():
+		return nil
+	case AuctionTypeDouble:
+		// the Auction was created from the double ArtPiece before attaching the SecondArtPiece
+		if auction.IsDouble() {
+			return nil
+		}
+	}
+	return ErrWrongAuctionType
+}
+
+// Run runs an Auction of the Game according to its AuctionType. The Game's MisbehaviorPolicy
+// decides what happens when a bidder returns an error or an invalid Bid, and every bid is
+// recorded in the Game's events.
+// If no bid beats the auctioneer's, the auctioneer gets the ArtPieces for free.
+func (a *Auction) Run(g *Game, bidders []*GamePlayer) error {
 	// get a bid from each player
 	// map[PlayerName]Bid
 	// TODO: consider this implementation. To collect data, might be better
 	// TODO: to have a map[PlayerName]Bid
-	// TODO: here is where we switch-case on AuctionType, for now just 1-shot
+	if a.WinningBid == nil {
+		a.WinningBid = NewBid(a.Auctioneer, 0)
+	}
 
 	switch a.Type {
 	case AuctionTypeOneShot:
-		runOneShotAuction(a, bidders)
+		return g.runOneShotAuction(a, bidders)
 	case AuctionTypeOpen:
-		runOpenAuction(a, bidders)
+		return g.runOpenAuction(a, bidders)
 	case AuctionTypeBlind:
-		runBlindAuction(a, bidders)
+		return g.runBlindAuction(a, bidders)
 	case AuctionTypeSetPrice:
-		runSetPriceAuction(a, bidders)
+		return g.runSetPriceAuction(a, bidders)
+	default:
+		return fmt.Errorf("%w: %q", ErrUnknownAuctionType, a.Type)
 	}
 }
 
@@ -62,8 +115,11 @@ func (a *Auction) HandleBid(bid *Bid) bool {
 }
 
 func validateBid(player *GamePlayer, bid *Bid) error {
-	if player.Player.Name() != bid.Bidder.Name() {
-		return fmt.Errorf("bidder %s does not match player %s", bid.Bidder.Name(), player.Player.Name())
+	if bid == nil || bid.Value < 0 {
+		return ErrInvalidBid
+	}
+	if bid.Bidder == nil || player.Player.Name() != bid.Bidder.Name() {
+		return ErrWrongBidder
 	}
 	if bid.Value > player.Money {
 		return ErrNotEnoughMoney
@@ -71,6 +127,38 @@ func validateBid(player *GamePlayer, bid *Bid) error {
 	return nil
 }
 
+// requestBid asks the bidder to Bid on the auction and validates the Bid, applying the
+// MisbehaviorPolicy if it is invalid. Returns a nil Bid if the bidder passes or runs out of time.
+// In an Auction of type AuctionTypeSetPrice, a nil Bid declines the price.
+func (g *Game) requestBid(auction *Auction, bidder *GamePlayer) (*Bid, error) {
+	for attempt := 0; ; attempt++ {
+		ctx, cancel := g.decisionContext()
+		bid, err := bidder.decider().BidContext(ctx, g.view(bidder), g.publicAuction(auction))
+		cancel()
+		if timedOut, err := g.checkTimeout(bidder, DecisionBid, err); timedOut || err != nil {
+			return nil, err
+		}
+		if err == nil && bid == nil && auction.Type == AuctionTypeSetPrice {
+			g.recordBid(EventPass, bidder, 0, false)
+			return nil, nil
+		}
+		if err == nil {
+			err = validateBid(bidder, bid)
+		}
+		if err == nil {
+			return bid, nil
+		}
+		retry, err := g.misbehaviorPolicy.handle(bidder, attempt, err)
+		if err != nil {
+			return nil, err
+		}
+		if !retry {
+			g.recordBid(EventPass, bidder, 0, false)
+			return nil, nil
+		}
+	}
+}
+
 // Bid is a bid on an Auction
 type Bid struct {
 	Bidder Player
@@ -95,123 +183,146 @@ type AuctionType string
 const (
 	// AuctionTypeOneShot means every player gets one bid sequentially, with the auctioneer going last.
 	AuctionTypeOneShot AuctionType = "one-shot"
-	// AuctionTypeOpen means every player can submit any number of bids until the auctioneer closes the auction.
+	// AuctionTypeOpen means players raise the standing bid in seat order any number of times until no one raises
+	// for three rounds, everyone else withdraws, or the auctioneer closes the auction.
 	AuctionTypeOpen AuctionType = "open"
 	// AuctionTypeBlind means every player submits a single bid simultaneously.
 	AuctionTypeBlind AuctionType = "blind"
-	// AuctionTypeSetPrice means the auctioneer sets a price and players sequentially get to accept or reject the price.
+	// AuctionTypeSetPrice means the auctioneer sets a price and the other players, starting left of the auctioneer,
+	// get to accept or reject the price. If no one accepts, the auctioneer buys at their own price.
 	AuctionTypeSetPrice AuctionType = "set-price"
+	// AuctionTypeDouble means the ArtPiece is sold together with a second ArtPiece of the same Artist.
+	// The Auction is run with the AuctionType of the second ArtPiece.
+	AuctionTypeDouble AuctionType = "double"
 )
 
+// AllAuctionTypes returns a slice of all AuctionTypes
+func AllAuctionTypes() []AuctionType {
+	return []AuctionType{AuctionTypeOpen, AuctionTypeOneShot, AuctionTypeBlind, AuctionTypeSetPrice, AuctionTypeDouble}
+}
+
 // runOneShotAuction runs an auction where every player gets one bid sequentially, with the auctioneer going last.
-func runOneShotAuction(auction *Auction, bidders []*GamePlayer) {
+func (g *Game) runOneShotAuction(auction *Auction, bidders []*GamePlayer) error {
 	// go around and collect bids. Update the auction each time its sent to the next player so they know the current bid
 	for _, bidder := range bidders {
-		bid, err := bidder.Player.Bid(auction)
+		bid, err := g.requestBid(auction, bidder)
 		if err != nil {
-			panic(err)
+			return err
 		}
-		if err := validateBid(bidder, bid); err != nil {
-			panic(err)
+		if bid != nil {
+			g.recordBid(EventBid, bidder, bid.Value, auction.HandleBid(bid))
 		}
-		auction.HandleBid(bid)
 	}
+	return nil
 }
 
-// runOpenAuction runs an auction where every player can submit any number of bids until the bidding is done.
-// TODO: allow auctioneer to end early? why?
-func runOpenAuction(auction *Auction, bidders []*GamePlayer) {
-	sends := make([]chan *Bid, len(bidders))
-	recvs := make([]chan *Bid, len(bidders))
-	// open channel with each player.
+// runBlindAuction runs an auction where every player submits a single bid simultaneously.
+// Ties go to the auctioneer, or else to the tied player closest to the auctioneer's left.
+func (g *Game) runBlindAuction(auction *Auction, bidders []*GamePlayer) error {
+	// Players do not see one another's bids, so we make a copy of the auction
+	// and send each player a copy of the auction with zero starting bid
+	staticAuction := auction.copy()
+	staticAuction.WinningBid = NewBid(auction.Auctioneer, 0)
+	// the bids are tallied on a sealed copy as well, since the actual auction is
+	// already part of the Phase every bidder can see
+	sealedAuction := auction.copy()
+	blindBids := make([]*Bid, 0, len(bidders))
 	for _, bidder := range bidders {
-		// game sends other bids to player
-		send := make(chan *Bid, 256)
-		// game recv's player's bids
-		recv := make(chan *Bid, 256)
-		bidder.Player.OpenBid(auction, send, recv)
-		sends = append(sends, send)
-		recvs = append(recvs, recv)
-	}
-
-	bids := make(chan *Bid)
-	go funnelBids(bids, recvs)
-
-	for {
-		bid, more := <-bids
-		if !more {
-			// bids channel closed, auction is over. Signal this to all players by closing their recv channels
-			for _, recv := range recvs {
-				close(recv)
-			}
-			return
+		bid, err := g.requestBid(staticAuction, bidder)
+		if err != nil {
+			return err
 		}
-		// if the bid is the new best, tell everyone about it.
-		if auction.HandleBid(bid) {
-			for _, send := range sends {
-				send <- bid
+		// Bidders are in seat order, so an earlier bidder keeps a tie, except against
+		// the auctioneer, who bids last
+		if bid != nil {
+			winning := sealedAuction.HandleBid(bid)
+			if !winning && bidder.Player.Name() == auction.Auctioneer.Name() && bid.Value == sealedAuction.WinningBid.Value {
+				sealedAuction.WinningBid = bid
+				winning = true
 			}
+			g.recordBid(EventBid, bidder, bid.Value, winning)
+			blindBids = append(blindBids, NewBid(bidder.Player, bid.Value))
 		}
 	}
+	// the bids are revealed together once everyone has bid
+	auction.WinningBid = sealedAuction.WinningBid
+	auction.BlindBids = blindBids
+	return nil
 }
 
-// funnelBids funnels bids from many channels into a single channel, for easy synchronous handling
-func funnelBids(bids chan *Bid, recvs []chan *Bid) {
-	var wg sync.WaitGroup
-	// when all but one bidder have closed their channels, close the bids channel, triggering the end of the auction
-	wg.Add(len(recvs) - 1)
-	for _, recv := range recvs {
-		go func(recv chan *Bid) {
-			for {
-				bid, more := <-recv
-				// if bidder closed the channel, stop listening
-				if !more {
-					wg.Done()
-					return
-				}
-				// otherwise, send the bid to the main channel
-				bids <- bid
-			}
-		}(recv)
+// runSetPriceAuction runs an auction where the auctioneer sets a price and the other players are offered
+// the price one at a time, clockwise from the auctioneer's left. A Bid of at least the price accepts it, a nil
+// Bid declines it, and the first player to accept buys the ArtPieces at the price. If no one accepts, the auctioneer must buy them
+// at their own price.
+func (g *Game) runSetPriceAuction(auction *Auction, bidders []*GamePlayer) error {
+	auctioneer := g.LookupGamePlayer(auction.Auctioneer.Name())
+	price, err := g.requestPrice(auction, auctioneer)
+	if err != nil {
+		return err
 	}
-	// when all bidders have closed their channels, close the bids channel, triggering the end of the auction
-	wg.Wait()
-	close(bids)
-}
+	// auction starts with auctioneer's bid being the set price. This way, if no one accepts, the auctioneer buys the piece
+	auction.WinningBid = NewBid(auction.Auctioneer, price)
 
-// runBlindAuction runs an auction where every player submits a single bid simultaneously.
-func runBlindAuction(auction *Auction, bidders []*GamePlayer) {
-	// Players do not see one another's bids, so we make a copy of the auction
-	// and send each player a copy of the auction with zero starting bid
-	staticAuction := NewAuction(auction.Auctioneer, auction.ArtPiece, NewBid(auction.Auctioneer, 0))
 	for _, bidder := range bidders {
-		bid, err := bidder.Player.Bid(staticAuction)
+		if bidder == auctioneer {
+			continue
+		}
+		bid, err := g.requestBid(auction, bidder)
 		if err != nil {
-			panic(err)
+			return err
 		}
-		if err := validateBid(bidder, bid); err != nil {
-			panic(err)
+		if bid == nil {
+			continue
 		}
-		// the actual auction is updated with the bid
-		auction.HandleBid(bid)
+		if bid.Value >= price {
+			// auction is over, price has been accepted
+			auction.WinningBid = NewBid(bidder.Player, price)
+			g.recordBid(EventBid, bidder, price, true)
+			return nil
+		}
+		g.recordBid(EventBid, bidder, bid.Value, false)
 	}
+	return nil
 }
 
-// runSetPriceAuction runs an auction where the auctioneer sets a price and players sequentially get to accept or reject the price.
-func runSetPriceAuction(auction *Auction, bidders []*GamePlayer) {
-	// auction starts with auctioneer's bid being the set price. This way, if no one bids, the auctioneer gets the piece
-	for _, bidder := range bidders {
-		bid, err := bidder.Player.Bid(auction)
-		if err != nil {
-			panic(err)
+// requestPrice asks the auctioneer to set the price of a set-price Auction and validates it,
+// applying the MisbehaviorPolicy if it is invalid. An auctioneer who passes sets a price of 0.
+func (g *Game) requestPrice(auction *Auction, auctioneer *GamePlayer) (int, error) {
+	for attempt := 0; ; attempt++ {
+		ctx, cancel := g.decisionContext()
+		price, err := auctioneer.decider().SetPriceContext(ctx, g.view(auctioneer), g.publicAuction(auction))
+		cancel()
+		if timedOut, err := g.checkTimeout(auctioneer, DecisionSetPrice, err); err != nil {
+			return 0, err
+		} else if timedOut {
+			g.recordBid(EventSetPrice, auctioneer, 0, false)
+			return 0, nil
 		}
-		if err := validateBid(bidder, bid); err != nil {
-			panic(err)
+		if err == nil {
+			err = validatePrice(auctioneer, price)
 		}
-		if bid.Value == auction.WinningBid.Value {
-			// auction is over, price has been accepted
-			auction.WinningBid = bid
-			return
+		if err == nil {
+			g.recordBid(EventSetPrice, auctioneer, price, false)
+			return price, nil
+		}
+		retry, err := g.misbehaviorPolicy.handle(auctioneer, attempt, err)
+		if err != nil {
+			return 0, err
 		}
+		if !retry {
+			g.recordBid(EventPass, auctioneer, 0, false)
+			return 0, nil
+		}
+	}
+}
+
+// validatePrice ensures the auctioneer can pay their own price, since they must buy the ArtPieces if no one accepts it
+func validatePrice(auctioneer *GamePlayer, price int) error {
+	if price < 0 {
+		return ErrInvalidPrice
 	}
+	if price > auctioneer.Money {
+		return ErrNotEnoughMoney
+	}
+	return nil
 }
@@ -0,0 +1,477 @@
+package game_test
+
+import (
+	"context"
+	"fmt"
+	"github.com/SachinMeier/modern-art.git/game"
+	"github.com/SachinMeier/modern-art.git/game/players"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+func TestAuctionSuite(t *testing.T) {
+	suite.Run(t, new(AuctionTestSuite))
+}
+
+type AuctionTestSuite struct {
+	suite.Suite
+	testCtx    context.Context
+	cancelFunc context.CancelFunc
+}
+
+func (suite *AuctionTestSuite) SetupSuite() {}
+
+func (suite *AuctionTestSuite) SetupTest() {
+	suite.testCtx, suite.cancelFunc = context.WithCancel(context.Background())
+}
+
+func (suite *AuctionTestSuite) TearDownTest() {
+	suite.cancelFunc()
+}
+
+func (suite *AuctionTestSuite) TearDownSuite() {}
+
+func (suite *AuctionTestSuite) Test_ArtPieceAuctionType() {
+	// 1. Test that an Auction takes the AuctionType of its ArtPiece
+	{
+		p1 := players.NewDummyPlayer("1")
+		artPiece := game.NewArtPieceWithAuctionType(game.Manuel, "manuel", game.AuctionTypeBlind)
+		auction := game.NewAuction(p1, artPiece, nil)
+		suite.Equal(game.AuctionTypeBlind, auction.Type)
+		suite.Equal(game.AuctionTypeBlind, auction.ArtPieceAuctionType())
+	}
+
+	// 2. Test that a double Auction takes the AuctionType of its SecondArtPiece
+	{
+		p1 := players.NewDummyPlayer("1")
+		auction := newDoubleAuctionWithWinningBid(p1, game.Manuel, game.AuctionTypeSetPrice, p1, 0)
+		suite.True(auction.IsDouble())
+		suite.Equal(game.AuctionTypeSetPrice, auction.ArtPieceAuctionType())
+	}
+}
+
+func (suite *AuctionTestSuite) Test_BlindAuction() {
+	// 1. Test that the highest bid wins and every bid is asked for left of the auctioneer, who bids last
+	{
+		ps, asked := suite.newBlindBidders(5, 10, 20, 15)
+		ng := suite.newBlindGame(ps)
+		_, err := ng.DoTurn()
+		suite.NoError(err)
+
+		suite.Equal([]string{"bidder-1", "bidder-2", "bidder-3", "bidder-0"}, *asked)
+		suite.Equal("bidder-2", ng.View("bidder-0").Phase().Auctions[0].WinningBid.Bidder.Name())
+	}
+
+	// 2. Test that a tie goes to the player closest to the auctioneer's left
+	{
+		ps, _ := suite.newBlindBidders(5, 20, 10, 20)
+		ng := suite.newBlindGame(ps)
+		for i := 0; i < 3; i++ {
+			_, err := ng.DoTurn()
+			suite.Require().NoError(err)
+		}
+
+		auctions := ng.View("bidder-0").Phase().Auctions
+		suite.Equal("bidder-1", auctions[0].WinningBid.Bidder.Name())
+		// bidder-3 sits closer to the left of bidder-2 than bidder-1 does
+		suite.Equal("bidder-3", auctions[2].WinningBid.Bidder.Name())
+	}
+
+	// 3. Test that a tie goes to the auctioneer
+	{
+		ps, _ := suite.newBlindBidders(20, 20, 20, 5)
+		ng := suite.newBlindGame(ps)
+		_, err := ng.DoTurn()
+		suite.NoError(err)
+
+		auction := ng.View("bidder-0").Phase().Auctions[0]
+		suite.Equal("bidder-0", auction.WinningBid.Bidder.Name())
+		suite.Equal(20, auction.WinningBid.Value)
+	}
+
+	// 4. Test that no bidder can see an earlier bid in the auction in progress
+	{
+		ps, asked, seen := suite.newWatchingBlindBidders(5, 10, 20, 15)
+		ng := suite.newBlindGame(ps)
+		_, err := ng.DoTurn()
+		suite.NoError(err)
+
+		suite.Equal(len(*asked), len(*seen))
+		for _, auction := range *seen {
+			suite.Equal("bidder-0", auction.WinningBid.Bidder.Name())
+			suite.Equal(0, auction.WinningBid.Value)
+			suite.Empty(auction.BlindBids)
+		}
+		suite.Equal(20, ng.View("bidder-0").Phase().Auctions[0].WinningBid.Value)
+	}
+}
+
+func (suite *AuctionTestSuite) Test_SetPriceAuction() {
+	// 1. Test that the price is offered clockwise from the auctioneer's left and the first acceptance wins
+	{
+		ps, offers := suite.newPricers(10, false, false, true, true)
+		ng := suite.newSetPriceGame(ps)
+		_, err := ng.DoTurn()
+		suite.NoError(err)
+
+		suite.Equal([]string{"pricer-1", "pricer-2"}, *offers)
+		auction := ng.View("pricer-0").Phase().Auctions[0]
+		suite.Equal("pricer-2", auction.WinningBid.Bidder.Name())
+		suite.Equal(10, auction.WinningBid.Value)
+		suite.Equal(officialRules.StartingMoney+10, ng.LookupGamePlayer("pricer-0").Money)
+		suite.Equal(officialRules.StartingMoney-10, ng.LookupGamePlayer("pricer-2").Money)
+	}
+
+	// 2. Test that the auctioneer buys at their own price and pays the bank if no one accepts
+	{
+		ps, offers := suite.newPricers(10, false, false, false, false)
+		ng := suite.newSetPriceGame(ps)
+		_, err := ng.DoTurn()
+		suite.NoError(err)
+
+		// the auctioneer is never offered their own price
+		suite.Equal([]string{"pricer-1", "pricer-2", "pricer-3"}, *offers)
+		auction := ng.View("pricer-0").Phase().Auctions[0]
+		suite.Equal("pricer-0", auction.WinningBid.Bidder.Name())
+		suite.Equal(10, auction.WinningBid.Value)
+		suite.Equal(officialRules.StartingMoney-10, ng.LookupGamePlayer("pricer-0").Money)
+		for _, name := range []string{"pricer-1", "pricer-2", "pricer-3"} {
+			suite.Equal(officialRules.StartingMoney, ng.LookupGamePlayer(name).Money)
+		}
+		transfer := ng.Events()[len(ng.Events())-1].Transfer
+		suite.Equal("pricer-0", transfer.From)
+		suite.Equal("", transfer.To)
+	}
+
+	// 3. Test that the price and the offers are recorded
+	{
+		ps, _ := suite.newPricers(10, false, false, true)
+		ng := suite.newSetPriceGame(ps)
+		_, err := ng.DoTurn()
+		suite.NoError(err)
+
+		types := make([]game.EventType, 0)
+		for _, event := range ng.Events() {
+			if event.Bid != nil {
+				types = append(types, event.Type)
+			}
+		}
+		suite.Equal([]game.EventType{game.EventSetPrice, game.EventBid, game.EventBid}, types)
+	}
+
+	// 4. Test that the price is capped at the auctioneer's money
+	{
+		ps, _ := suite.newPricers(officialRules.StartingMoney+1, true, true, true)
+		ng := suite.newSetPriceGame(ps)
+		_, err := ng.DoTurn()
+		suite.ErrorIs(err, game.ErrNotEnoughMoney)
+
+		ps, _ = suite.newPricers(-1, true, true, true)
+		ng = suite.newSetPriceGame(ps)
+		_, err = ng.DoTurn()
+		suite.ErrorIs(err, game.ErrInvalidPrice)
+	}
+
+	// 5. Test that a nil Bid declines a price of 0 without misbehaving, so the auctioneer keeps the ArtPiece
+	{
+		ps, offers := suite.newPricers(0, false, false, false, true)
+		for _, p := range ps[:3] {
+			p.(*pricer).pass = true
+		}
+		ng := suite.newSetPriceGame(ps)
+		_, err := ng.DoTurn()
+		suite.NoError(err)
+
+		suite.Equal([]string{"pricer-1", "pricer-2", "pricer-3"}, *offers)
+		auction := ng.View("pricer-0").Phase().Auctions[0]
+		suite.Equal("pricer-3", auction.WinningBid.Bidder.Name())
+		suite.Equal(0, auction.WinningBid.Value)
+
+		ps, _ = suite.newPricers(0, false, false, false)
+		for _, p := range ps {
+			p.(*pricer).pass = true
+		}
+		ng = suite.newSetPriceGame(ps)
+		_, err = ng.DoTurn()
+		suite.NoError(err)
+		suite.Equal("pricer-0", ng.View("pricer-0").Phase().Auctions[0].WinningBid.Bidder.Name())
+		types := make([]game.EventType, 0)
+		for _, event := range ng.Events() {
+			if event.Bid != nil {
+				types = append(types, event.Type)
+			}
+		}
+		suite.Equal([]game.EventType{game.EventSetPrice, game.EventPass, game.EventPass}, types)
+	}
+}
+
+func (suite *AuctionTestSuite) Test_OpenAuction() {
+	// 1. Test that the auction is sold after going once, going twice and a third round without a raise
+	{
+		ps, moves := suite.newOpenBidders(
+			[]*game.OpenBid{},
+			[]*game.OpenBid{suite.raise(5)},
+			[]*game.OpenBid{},
+		)
+		ng := suite.newOpenGame(ps)
+		_, err := ng.DoTurn()
+		suite.NoError(err)
+
+		auction := ng.View("bidder-0").Phase().Auctions[0]
+		suite.Equal("bidder-1", auction.WinningBid.Bidder.Name())
+		suite.Equal(5, auction.WinningBid.Value)
+		// the standing bidder is not asked and everyone else passes through three quiet rounds
+		suite.Equal([]string{
+			"bidder-1 bidding", "bidder-2 bidding", "bidder-0 bidding",
+			"bidder-2 bidding", "bidder-0 bidding",
+			"bidder-2 going once", "bidder-0 going once",
+			"bidder-2 going twice", "bidder-0 going twice",
+		}, *moves)
+	}
+
+	// 2. Test that a raise resets the going call
+	{
+		ps, moves := suite.newOpenBidders(
+			[]*game.OpenBid{},
+			[]*game.OpenBid{suite.raise(5)},
+			[]*game.OpenBid{game.NewPass(), game.NewPass(), suite.raise(6)},
+		)
+		ng := suite.newOpenGame(ps)
+		_, err := ng.DoTurn()
+		suite.NoError(err)
+
+		suite.Equal("bidder-2", ng.View("bidder-0").Phase().Auctions[0].WinningBid.Bidder.Name())
+		suite.Contains(*moves, "bidder-1 bidding")
+		suite.Equal("bidder-0 going twice", (*moves)[len(*moves)-1])
+		suite.Contains(*moves, "bidder-1 going twice")
+	}
+
+	// 3. Test that the auction ends when everyone but the standing bidder withdraws
+	{
+		ps, moves := suite.newOpenBidders(
+			[]*game.OpenBid{game.NewWithdrawal()},
+			[]*game.OpenBid{suite.raise(5)},
+			[]*game.OpenBid{game.NewWithdrawal()},
+		)
+		ng := suite.newOpenGame(ps)
+		_, err := ng.DoTurn()
+		suite.NoError(err)
+
+		suite.Equal([]string{"bidder-1 bidding", "bidder-2 bidding", "bidder-0 bidding"}, *moves)
+		suite.Equal("bidder-1", ng.View("bidder-0").Phase().Auctions[0].WinningBid.Bidder.Name())
+	}
+
+	// 4. Test that the auctioneer can close the auction
+	{
+		ps, moves := suite.newOpenBidders(
+			[]*game.OpenBid{game.NewClose()},
+			[]*game.OpenBid{suite.raise(5)},
+			[]*game.OpenBid{},
+		)
+		ng := suite.newOpenGame(ps)
+		_, err := ng.DoTurn()
+		suite.NoError(err)
+
+		suite.Equal([]string{"bidder-1 bidding", "bidder-2 bidding", "bidder-0 bidding"}, *moves)
+		suite.Equal("bidder-1", ng.View("bidder-0").Phase().Auctions[0].WinningBid.Bidder.Name())
+		events := ng.Events()
+		close := events[len(events)-3]
+		suite.Equal(game.EventClose, close.Type)
+		suite.Equal("bidder-0", close.Bid.Bidder)
+		suite.Equal(5, close.Bid.Value)
+		suite.Equal(game.EventAuctionResult, events[len(events)-2].Type)
+	}
+
+	// 5. Test that invalid moves are rejected
+	{
+		for _, tc := range []struct {
+			openBid *game.OpenBid
+			err     error
+		}{
+			{suite.raise(5), game.ErrBidTooLow},
+			{game.NewClose(), game.ErrNotAuctioneer},
+			{&game.OpenBid{Type: "shout"}, game.ErrInvalidOpenBid},
+			{nil, game.ErrInvalidOpenBid},
+		} {
+			ps, _ := suite.newOpenBidders(
+				[]*game.OpenBid{},
+				[]*game.OpenBid{suite.raise(5)},
+				[]*game.OpenBid{tc.openBid},
+			)
+			ng := suite.newOpenGame(ps)
+			_, err := ng.DoTurn()
+			suite.ErrorIs(err, tc.err)
+		}
+	}
+
+	// 6. Test that the auctioneer is asked while holding the standing bid and can close but not raise it
+	{
+		ps, moves := suite.newOpenBidders(
+			[]*game.OpenBid{game.NewPass(), game.NewClose()},
+			[]*game.OpenBid{},
+			[]*game.OpenBid{},
+		)
+		ng := suite.newOpenGame(ps)
+		_, err := ng.DoTurn()
+		suite.NoError(err)
+
+		suite.Equal([]string{
+			"bidder-1 bidding", "bidder-2 bidding", "bidder-0 bidding",
+			"bidder-1 going once", "bidder-2 going once", "bidder-0 going once",
+		}, *moves)
+		auction := ng.View("bidder-0").Phase().Auctions[0]
+		suite.Equal("bidder-0", auction.WinningBid.Bidder.Name())
+		suite.Equal(0, auction.WinningBid.Value)
+
+		ps, _ = suite.newOpenBidders(
+			[]*game.OpenBid{suite.raise(5), suite.raise(6)},
+			[]*game.OpenBid{},
+			[]*game.OpenBid{},
+		)
+		ng = suite.newOpenGame(ps)
+		_, err = ng.DoTurn()
+		suite.ErrorIs(err, game.ErrStandingBidder)
+	}
+}
+
+// helpers
+
+// openBidder makes the scripted moves in open Auctions and passes once they run out.
+// It records each time it is asked, with the going call, in a list shared with the other openBidders.
+type openBidder struct {
+	*players.DummyPlayer
+	script []*game.OpenBid
+	moves  *[]string
+}
+
+func (p *openBidder) OpenBid(view *game.GameView, auction *game.Auction, going game.Going) (*game.OpenBid, error) {
+	*p.moves = append(*p.moves, fmt.Sprintf("%s %s", p.Name(), going))
+	if len(p.script) == 0 {
+		return game.NewPass(), nil
+	}
+	openBid := p.script[0]
+	p.script = p.script[1:]
+	if openBid != nil && openBid.Bid != nil {
+		openBid.Bid.Bidder = p
+	}
+	return openBid, nil
+}
+
+// raise creates a raise to the value. The openBidder fills in the Bidder.
+func (suite *AuctionTestSuite) raise(value int) *game.OpenBid {
+	return game.NewRaise(game.NewBid(nil, value))
+}
+
+// newOpenBidders creates an openBidder for each script
+func (suite *AuctionTestSuite) newOpenBidders(scripts ...[]*game.OpenBid) ([]game.Player, *[]string) {
+	moves := make([]string, 0)
+	ps := make([]game.Player, len(scripts))
+	for i, script := range scripts {
+		ps[i] = &openBidder{
+			DummyPlayer: players.NewDummyPlayer(fmt.Sprintf("bidder-%d", i)),
+			script:      script,
+			moves:       &moves,
+		}
+	}
+	return ps, &moves
+}
+
+// newOpenGame creates a Game dealing only open ArtPieces
+func (suite *AuctionTestSuite) newOpenGame(ps []game.Player) *game.Game {
+	ng := mustNewGame(&suite.Suite, ps, game.WithSeed(7))
+	ng.ArtPieces = newDeck(game.Manuel, game.AuctionTypeOpen, 40)
+	return ng
+}
+
+// blindBidder bids a fixed value. It records the times it is asked in a list shared with the other blindBidders.
+type blindBidder struct {
+	*players.DummyPlayer
+	value int
+	asked *[]string
+	seen  *[]*game.Auction
+}
+
+func (p *blindBidder) Bid(view *game.GameView, auction *game.Auction) (*game.Bid, error) {
+	*p.asked = append(*p.asked, p.Name())
+	auctions := view.Phase().Auctions
+	*p.seen = append(*p.seen, auctions[len(auctions)-1])
+	return game.NewBid(p, p.value), nil
+}
+
+// newBlindBidders creates a blindBidder for each value
+func (suite *AuctionTestSuite) newBlindBidders(values ...int) ([]game.Player, *[]string) {
+	ps, asked, _ := suite.newWatchingBlindBidders(values...)
+	return ps, asked
+}
+
+// newWatchingBlindBidders creates a blindBidder for each value and also returns the
+// auctions in progress the bidders saw in their views
+func (suite *AuctionTestSuite) newWatchingBlindBidders(values ...int) ([]game.Player, *[]string, *[]*game.Auction) {
+	asked := make([]string, 0)
+	seen := make([]*game.Auction, 0)
+	ps := make([]game.Player, len(values))
+	for i, value := range values {
+		ps[i] = &blindBidder{
+			DummyPlayer: players.NewDummyPlayer(fmt.Sprintf("bidder-%d", i)),
+			value:       value,
+			asked:       &asked,
+			seen:        &seen,
+		}
+	}
+	return ps, &asked, &seen
+}
+
+// newBlindGame creates a Game dealing only blind ArtPieces
+func (suite *AuctionTestSuite) newBlindGame(ps []game.Player) *game.Game {
+	ng := mustNewGame(&suite.Suite, ps, game.WithSeed(7))
+	ng.ArtPieces = newDeck(game.Manuel, game.AuctionTypeBlind, 40)
+	return ng
+}
+
+// pricer sets a fixed price and accepts or declines every price offered to it, declining with a
+// Bid of 0 or, if it passes, with a nil Bid. It records the offers it receives in a list shared
+// with the other pricers.
+type pricer struct {
+	*players.DummyPlayer
+	price  int
+	accept bool
+	pass   bool
+	offers *[]string
+}
+
+func (p *pricer) SetPrice(view *game.GameView, auction *game.Auction) (int, error) {
+	return p.price, nil
+}
+
+func (p *pricer) Bid(view *game.GameView, auction *game.Auction) (*game.Bid, error) {
+	*p.offers = append(*p.offers, p.Name())
+	if p.accept {
+		return game.NewBid(p, auction.WinningBid.Value), nil
+	}
+	if p.pass {
+		return nil, nil
+	}
+	return game.NewBid(p, 0), nil
+}
+
+// newPricers creates a pricer for each accept, all setting the price
+func (suite *AuctionTestSuite) newPricers(price int, accepts ...bool) ([]game.Player, *[]string) {
+	offers := make([]string, 0)
+	ps := make([]game.Player, len(accepts))
+	for i, accept := range accepts {
+		ps[i] = &pricer{
+			DummyPlayer: players.NewDummyPlayer(fmt.Sprintf("pricer-%d", i)),
+			price:       price,
+			accept:      accept,
+			offers:      &offers,
+		}
+	}
+	return ps, &offers
+}
+
+// newSetPriceGame creates a Game dealing only set-price ArtPieces
+func (suite *AuctionTestSuite) newSetPriceGame(ps []game.Player) *game.Game {
+	ng := mustNewGame(&suite.Suite, ps, game.WithSeed(7))
+	ng.ArtPieces = newDeck(game.Manuel, game.AuctionTypeSetPrice, 40)
+	return ng
+}
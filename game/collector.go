@@ -0,0 +1,67 @@
+package game
+
+// CollectorName is the name of the dummy collector of RuleSets with DummyCollector
+const CollectorName = "collector"
+
+// collector is the dummy collector of RuleSets with DummyCollector. It auctions the first ArtPiece
+// in its hand every turn, sets half its money as the price, and otherwise bids nothing.
+type collector struct{}
+
+// Ensures that collector implements Player interface at compile time
+var _ Player = &collector{}
+
+func newCollector() *collector {
+	return &collector{}
+}
+
+// isCollector returns true if the Player is the dummy collector
+func isCollector(player Player) bool {
+	_, ok := player.(*collector)
+	return ok
+}
+
+// Name returns the Player's name
+func (c *collector) Name() string {
+	return CollectorName
+}
+
+// HoldAuction auctions the first ArtPiece in the hand, or passes if it is empty
+func (c *collector) HoldAuction(view *GameView) (*Auction, error) {
+	hand := view.Hand()
+	if len(hand) == 0 {
+		return nil, nil
+	}
+	return NewAuction(c, hand[0], nil), nil
+}
+
+// OfferDouble always declines
+func (c *collector) OfferDouble(view *GameView, auction *Auction) (*ArtPiece, error) {
+	return nil, nil
+}
+
+// SetPrice sets half the collector's money as the price
+func (c *collector) SetPrice(view *GameView, auction *Auction) (int, error) {
+	return view.Money() / 2, nil
+}
+
+// Bid declines every set price and otherwise bids 0
+func (c *collector) Bid(view *GameView, auction *Auction) (*Bid, error) {
+	if auction.Type == AuctionTypeSetPrice {
+		return nil, nil
+	}
+	return NewBid(c, 0), nil
+}
+
+// OpenBid always withdraws
+func (c *collector) OpenBid(view *GameView, auction *Auction, going Going) (*OpenBid, error) {
+	return NewWithdrawal(), nil
+}
+
+// HandleAuctionResult does nothing. The collector reads everything from its GameView.
+func (c *collector) HandleAuctionResult(auction *Auction) {}
+
+// AddArtPieces does nothing
+func (c *collector) AddArtPieces(artPieces []*ArtPiece) {}
+
+// MoveMoney does nothing
+func (c *collector) MoveMoney(amount int) {}
@@ -0,0 +1,172 @@
+package game
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ContextPlayer is a Player whose decisions can be cancelled. The Game calls the Context methods
+// instead of the blocking ones, with a context that is done when the decision's deadline passes or
+// the Game is cancelled. Players that do not implement it are wrapped with NewContextPlayer.
+type ContextPlayer interface {
+	Player
+	HoldAuctionContext(context.Context, *GameView) (*Auction, error)
+	OfferDoubleContext(context.Context, *GameView, *Auction) (*ArtPiece, error)
+	SetPriceContext(context.Context, *GameView, *Auction) (int, error)
+	BidContext(context.Context, *GameView, *Auction) (*Bid, error)
+	OpenBidContext(context.Context, *GameView, *Auction, Going) (*OpenBid, error)
+}
+
+// Decision is a decision the Game asks a Player to make
+type Decision string
+
+// Decisions
+const (
+	DecisionHoldAuction Decision = "hold-auction"
+	DecisionOfferDouble Decision = "offer-double"
+	DecisionSetPrice    Decision = "set-price"
+	DecisionBid         Decision = "bid"
+	DecisionOpenBid     Decision = "open-bid"
+)
+
+// NewContextPlayer returns the Player as a ContextPlayer. A Player that implements ContextPlayer
+// is returned as it is. Any other Player is called directly if the context can never be done, and
+// otherwise makes each decision in its own goroutine, which is abandoned when the context is done.
+// The abandoned decision keeps running until the Player returns, and the next decision waits for it,
+// so Players that may be slow should implement ContextPlayer themselves. A decision that panics
+// returns ErrPlayerPanic instead.
+func NewContextPlayer(player Player) ContextPlayer {
+	if contextPlayer, ok := player.(ContextPlayer); ok {
+		return contextPlayer
+	}
+	return &contextAdapter{Player: player, busy: make(chan struct{}, 1)}
+}
+
+// contextAdapter makes a Player's decisions cancellable. Each decision is given its own
+// copy of the Auction, since an abandoned decision may read it while the Game goes on.
+// busy is held while a decision runs, so that the Player never makes two at once.
+type contextAdapter struct {
+	Player
+	busy chan struct{}
+}
+
+func (a *contextAdapter) HoldAuctionContext(ctx context.Context, view *GameView) (*Auction, error) {
+	return await(ctx, a.busy, func() (*Auction, error) {
+		return a.HoldAuction(view)
+	})
+}
+
+func (a *contextAdapter) OfferDoubleContext(ctx context.Context, view *GameView, auction *Auction) (*ArtPiece, error) {
+	auction = copyAuction(auction)
+	return await(ctx, a.busy, func() (*ArtPiece, error) {
+		return a.OfferDouble(view, auction)
+	})
+}
+
+func (a *contextAdapter) SetPriceContext(ctx context.Context, view *GameView, auction *Auction) (int, error) {
+	auction = copyAuction(auction)
+	return await(ctx, a.busy, func() (int, error) {
+		return a.SetPrice(view, auction)
+	})
+}
+
+func (a *contextAdapter) BidContext(ctx context.Context, view *GameView, auction *Auction) (*Bid, error) {
+	auction = copyAuction(auction)
+	return await(ctx, a.busy, func() (*Bid, error) {
+		return a.Bid(view, auction)
+	})
+}
+
+func (a *contextAdapter) OpenBidContext(ctx context.Context, view *GameView, auction *Auction, going Going) (*OpenBid, error) {
+	auction = copyAuction(auction)
+	return await(ctx, a.busy, func() (*OpenBid, error) {
+		return a.OpenBid(view, auction, going)
+	})
+}
+
+// await runs the decision once the Player is no longer busy and returns its result, or the context's error
+// if it is done first. The decision runs in its own goroutine unless the context can never be done.
+func await[T any](ctx context.Context, busy chan struct{}, decide func() (T, error)) (T, error) {
+	var zero T
+	if ctx.Done() == nil {
+		busy <- struct{}{}
+		defer func() { <-busy }()
+		return recoverDecision(decide)
+	}
+	select {
+	case busy <- struct{}{}:
+	case <-ctx.Done():
+		return zero, ctx.Err()
+	}
+	type result struct {
+		value T
+		err   error
+	}
+	done := make(chan result, 1)
+	go func() {
+		defer func() { <-busy }()
+		value, err := recoverDecision(decide)
+		done <- result{value: value, err: err}
+	}()
+	select {
+	case r := <-done:
+		return r.value, r.err
+	case <-ctx.Done():
+		return zero, ctx.Err()
+	}
+}
+
+// recoverDecision runs the decision and returns ErrPlayerPanic if it panics
+func recoverDecision[T any](decide func() (T, error)) (value T, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			var zero T
+			value, err = zero, fmt.Errorf("%w: %v", ErrPlayerPanic, r)
+		}
+	}()
+	return decide()
+}
+
+// copyAuction copies the Auction and its WinningBid
+func copyAuction(auction *Auction) *Auction {
+	newAuction := auction.copy()
+	if auction.WinningBid != nil {
+		newAuction.WinningBid = NewBid(auction.WinningBid.Bidder, auction.WinningBid.Value)
+	}
+	newAuction.BlindBids = nil
+	for _, bid := range auction.BlindBids {
+		newAuction.BlindBids = append(newAuction.BlindBids, NewBid(bid.Bidder, bid.Value))
+	}
+	return newAuction
+}
+
+// context returns the context of the Game
+func (g *Game) context() context.Context {
+	if g.ctx == nil {
+		return context.Background()
+	}
+	return g.ctx
+}
+
+// decisionContext returns the context of a single decision, which is done when the decision
+// timeout passes or the Game is cancelled. Without a timeout, it is the Game's context.
+func (g *Game) decisionContext() (context.Context, context.CancelFunc) {
+	if g.decisionTimeout > 0 {
+		return context.WithTimeout(g.context(), g.decisionTimeout)
+	}
+	return g.context(), func() {}
+}
+
+// checkTimeout returns true if a decision failed because its deadline passed, and records the timeout.
+// If the Game was cancelled, it returns the Game's context error to stop the Game with.
+func (g *Game) checkTimeout(player *GamePlayer, decision Decision, err error) (bool, error) {
+	if ctxErr := g.context().Err(); ctxErr != nil {
+		return false, ctxErr
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		return false, nil
+	}
+	g.emit(&Event{Type: EventTimeout, Timeout: &TimeoutEvent{Player: player.Player.Name(), Decision: decision}})
+	return true, nil
+}
@@ -0,0 +1,272 @@
+package game_test
+
+import (
+	"context"
+	"fmt"
+	"github.com/SachinMeier/modern-art.git/game"
+	"github.com/SachinMeier/modern-art.git/game/players"
+	"github.com/stretchr/testify/suite"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestContextSuite(t *testing.T) {
+	suite.Run(t, new(ContextTestSuite))
+}
+
+type ContextTestSuite struct {
+	suite.Suite
+	testCtx    context.Context
+	cancelFunc context.CancelFunc
+}
+
+func (suite *ContextTestSuite) SetupSuite() {}
+
+func (suite *ContextTestSuite) SetupTest() {
+	suite.testCtx, suite.cancelFunc = context.WithCancel(context.Background())
+}
+
+func (suite *ContextTestSuite) TearDownTest() {
+	suite.cancelFunc()
+}
+
+func (suite *ContextTestSuite) TearDownSuite() {}
+
+func (suite *ContextTestSuite) Test_DecisionTimeout() {
+	// 1. Test that a bidder who misses the deadline passes and the timeout is recorded
+	{
+		ps := append(suite.getNDummyPlayers(3), newSleeper("sleeper", time.Second))
+		ng := mustNewGame(&suite.Suite, ps, game.WithSeed(7), game.WithDecisionTimeout(10*time.Millisecond))
+		_, err := ng.DoTurn()
+		suite.Require().NoError(err)
+
+		timeouts := suite.timeouts(ng.Events())
+		suite.Require().Equal(1, len(timeouts))
+		suite.Equal("sleeper", timeouts[0].Player)
+		suite.Equal(game.DecisionBid, timeouts[0].Decision)
+		suite.NotEqual("sleeper", ng.View("dummy-0").Phase().Auctions[0].WinningBid.Bidder.Name())
+	}
+
+	// 2. Test that an auctioneer who misses the deadline auctions an ArtPiece from their hand
+	{
+		ps := append([]game.Player{newSleeper("sleeper", time.Second)}, suite.getNDummyPlayers(3)...)
+		ng := mustNewGame(&suite.Suite, ps, game.WithSeed(7), game.WithDecisionTimeout(10*time.Millisecond))
+		_, err := ng.DoTurn()
+		suite.Require().NoError(err)
+
+		timeouts := suite.timeouts(ng.Events())
+		suite.Require().NotEmpty(timeouts)
+		suite.Equal(game.TimeoutEvent{Player: "sleeper", Decision: game.DecisionHoldAuction}, *timeouts[0])
+		suite.Equal(1, ng.View("dummy-0").Phase().Len())
+		handSize := officialRules.ArtPiecesToDeal(4, game.Phase1)
+		suite.Less(len(ng.LookupGamePlayer("sleeper").Hand), handSize)
+	}
+
+	// 3. Test that a ContextPlayer is given a context with the deadline
+	{
+		recorder := &deadlineRecorder{DummyPlayer: players.NewDummyPlayer("recorder")}
+		ps := append(suite.getNDummyPlayers(3), recorder)
+		ng := mustNewGame(&suite.Suite, ps, game.WithSeed(7), game.WithDecisionTimeout(time.Minute))
+		_, err := ng.DoTurn()
+		suite.Require().NoError(err)
+		suite.True(recorder.hadDeadline)
+	}
+
+	// 4. Test that without a timeout, decisions have no deadline
+	{
+		recorder := &deadlineRecorder{DummyPlayer: players.NewDummyPlayer("recorder")}
+		ps := append(suite.getNDummyPlayers(3), recorder)
+		ng := mustNewGame(&suite.Suite, ps, game.WithSeed(7))
+		_, err := ng.DoTurn()
+		suite.Require().NoError(err)
+		suite.False(recorder.hadDeadline)
+		suite.Empty(suite.timeouts(ng.Events()))
+	}
+}
+
+func (suite *ContextTestSuite) Test_Cancel() {
+	// 1. Test that a cancelled Game stops with the context's error
+	{
+		ctx, cancel := context.WithCancel(suite.testCtx)
+		cancel()
+		ng := mustNewGame(&suite.Suite, suite.getNDummyPlayers(4), game.WithSeed(7), game.WithContext(ctx))
+		_, err := ng.DoTurn()
+		suite.ErrorIs(err, context.Canceled)
+	}
+
+	// 2. Test that cancelling the Game abandons a slow decision
+	{
+		ctx, cancel := context.WithTimeout(suite.testCtx, 10*time.Millisecond)
+		defer cancel()
+		ps := append(suite.getNDummyPlayers(3), newSleeper("sleeper", time.Second))
+		ng := mustNewGame(&suite.Suite, ps, game.WithSeed(7), game.WithContext(ctx))
+		_, err := ng.DoTurn()
+		suite.ErrorIs(err, context.DeadlineExceeded)
+		suite.Empty(suite.timeouts(ng.Events()))
+	}
+}
+
+func (suite *ContextTestSuite) Test_Panic() {
+	// 1. Test that a panicking Player is a misbehaving Player, with or without a deadline
+	{
+		for _, opts := range [][]game.GameOption{
+			{game.WithSeed(7)},
+			{game.WithSeed(7), game.WithDecisionTimeout(time.Minute)},
+			{game.WithSeed(7), game.WithContext(suite.testCtx)},
+		} {
+			ps := append(suite.getNDummyPlayers(3), &panicker{DummyPlayer: players.NewDummyPlayer("panicker")})
+			ng := mustNewGame(&suite.Suite, ps, opts...)
+			_, err := ng.DoTurn()
+			suite.ErrorIs(err, game.ErrPlayerPanic)
+			var playerErr *game.PlayerError
+			suite.Require().ErrorAs(err, &playerErr)
+			suite.Equal("panicker", playerErr.Player)
+
+			ps = append(suite.getNDummyPlayers(3), &panicker{DummyPlayer: players.NewDummyPlayer("panicker")})
+			ng = mustNewGame(&suite.Suite, ps, append(opts, game.WithMisbehaviorPolicy(game.MisbehaviorForfeit))...)
+			_, err = ng.Start()
+			suite.NoError(err)
+			suite.True(ng.LookupGamePlayer("panicker").Forfeited)
+		}
+	}
+}
+
+func (suite *ContextTestSuite) Test_Busy() {
+	// 1. Test that a Player is not asked again while a decision abandoned at its deadline is still running
+	{
+		counter := &concurrencyCounter{DummyPlayer: players.NewDummyPlayer("counter"), delay: 20 * time.Millisecond}
+		ps := append(suite.getNDummyPlayers(3), counter)
+		ng := mustNewGame(&suite.Suite, ps, game.WithSeed(7), game.WithDecisionTimeout(5*time.Millisecond))
+		for i := 0; i < 8; i++ {
+			_, err := ng.DoTurn()
+			suite.Require().NoError(err)
+		}
+		suite.NotEmpty(suite.timeouts(ng.Events()))
+		suite.Equal(int32(1), counter.max.Load())
+	}
+}
+
+func (suite *ContextTestSuite) Test_ReplayTimeout() {
+	// 1. Test that a Game with timeouts replays without diverging
+	{
+		ps := append(suite.getNDummyPlayers(3), newSleeper("sleeper", time.Second))
+		ng := mustNewGame(&suite.Suite, ps, game.WithSeed(7), game.WithDecisionTimeout(10*time.Millisecond))
+		for i := 0; i < 4; i++ {
+			_, err := ng.DoTurn()
+			suite.Require().NoError(err)
+		}
+		suite.Require().NotEmpty(suite.timeouts(ng.Events()))
+
+		replayed, err := game.Replay(ng.Events())
+		suite.NoError(err)
+		suite.Equal(len(ng.Events()), len(replayed.Events()))
+	}
+}
+
+// helpers
+
+// sleeper is a DummyPlayer who takes too long to auction or bid. It does not touch its state
+// after sleeping, since the Game has moved on by then.
+type sleeper struct {
+	*players.DummyPlayer
+	delay time.Duration
+}
+
+func newSleeper(name string, delay time.Duration) *sleeper {
+	return &sleeper{DummyPlayer: players.NewDummyPlayer(name), delay: delay}
+}
+
+func (p *sleeper) HoldAuction(view *game.GameView) (*game.Auction, error) {
+	time.Sleep(p.delay)
+	return nil, nil
+}
+
+func (p *sleeper) Bid(view *game.GameView, auction *game.Auction) (*game.Bid, error) {
+	time.Sleep(p.delay)
+	return nil, nil
+}
+
+// panicker panics when asked to bid
+type panicker struct {
+	*players.DummyPlayer
+}
+
+func (p *panicker) Bid(view *game.GameView, auction *game.Auction) (*game.Bid, error) {
+	panic("panicker always panics")
+}
+
+// concurrencyCounter is slow to bid and records the most bids it made at once
+type concurrencyCounter struct {
+	*players.DummyPlayer
+	delay   time.Duration
+	running atomic.Int32
+	max     atomic.Int32
+}
+
+func (p *concurrencyCounter) Bid(view *game.GameView, auction *game.Auction) (*game.Bid, error) {
+	running := p.running.Add(1)
+	defer p.running.Add(-1)
+	for {
+		max := p.max.Load()
+		if running <= max || p.max.CompareAndSwap(max, running) {
+			break
+		}
+	}
+	time.Sleep(p.delay)
+	return nil, nil
+}
+
+func (p *concurrencyCounter) OpenBid(view *game.GameView, auction *game.Auction, going game.Going) (*game.OpenBid, error) {
+	_, err := p.Bid(view, auction)
+	return game.NewWithdrawal(), err
+}
+
+// deadlineRecorder is a ContextPlayer that records whether its Bid had a deadline
+type deadlineRecorder struct {
+	*players.DummyPlayer
+	hadDeadline bool
+}
+
+var _ game.ContextPlayer = &deadlineRecorder{}
+
+func (p *deadlineRecorder) HoldAuctionContext(ctx context.Context, view *game.GameView) (*game.Auction, error) {
+	return p.HoldAuction(view)
+}
+
+func (p *deadlineRecorder) OfferDoubleContext(ctx context.Context, view *game.GameView, auction *game.Auction) (*game.ArtPiece, error) {
+	return p.OfferDouble(view, auction)
+}
+
+func (p *deadlineRecorder) SetPriceContext(ctx context.Context, view *game.GameView, auction *game.Auction) (int, error) {
+	return p.SetPrice(view, auction)
+}
+
+func (p *deadlineRecorder) BidContext(ctx context.Context, view *game.GameView, auction *game.Auction) (*game.Bid, error) {
+	_, p.hadDeadline = ctx.Deadline()
+	return p.Bid(view, auction)
+}
+
+func (p *deadlineRecorder) OpenBidContext(ctx context.Context, view *game.GameView, auction *game.Auction, going game.Going) (*game.OpenBid, error) {
+	_, p.hadDeadline = ctx.Deadline()
+	return p.OpenBid(view, auction, going)
+}
+
+// timeouts returns the TimeoutEvents in the record
+func (suite *ContextTestSuite) timeouts(record []*game.Event) []*game.TimeoutEvent {
+	timeouts := make([]*game.TimeoutEvent, 0)
+	for _, event := range record {
+		if event.Type == game.EventTimeout {
+			timeouts = append(timeouts, event.Timeout)
+		}
+	}
+	return timeouts
+}
+
+func (suite *ContextTestSuite) getNDummyPlayers(n int) []game.Player {
+	ps := make([]game.Player, n)
+	for i := 0; i < n; i++ {
+		ps[i] = players.NewDummyPlayer(fmt.Sprintf("dummy-%d", i))
+	}
+	return ps
+}
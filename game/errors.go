@@ -3,7 +3,46 @@ package game
 import "fmt"
 
 var (
-	ErrNotEnoughMoney   = fmt.Errorf("player bid more money than they have")
-	ErrArtPieceNotFound = fmt.Errorf("player does not have this card")
-	ErrNoArtPieceToSell = fmt.Errorf("player has no art pieces to sell")
+	ErrNotEnoughMoney     = fmt.Errorf("player bid more money than they have")
+	ErrArtPieceNotFound   = fmt.Errorf("player does not have this card")
+	ErrNoArtPieceToSell   = fmt.Errorf("player has no art pieces to sell")
+	ErrInvalidDouble      = fmt.Errorf("second art piece must be a non-double by the same artist")
+	ErrWrongAuctionType   = fmt.Errorf("auction type does not match the art piece")
+	ErrInvalidAuction     = fmt.Errorf("player did not put up an art piece for auction")
+	ErrInvalidBid         = fmt.Errorf("bid must be non-nil and non-negative")
+	ErrInvalidPrice       = fmt.Errorf("price must be non-negative")
+	ErrInvalidOpenBid     = fmt.Errorf("open bid must be a raise, pass, withdrawal or close")
+	ErrBidTooLow          = fmt.Errorf("bid must beat the standing bid")
+	ErrNotAuctioneer      = fmt.Errorf("only the auctioneer can close the auction")
+	ErrStandingBidder     = fmt.Errorf("bidder already holds the standing bid")
+	ErrWrongBidder        = fmt.Errorf("bidder does not match player")
+	ErrUnknownAuctionType = fmt.Errorf("unknown auction type")
+	ErrInvalidRecord      = fmt.Errorf("invalid game record")
+	ErrReplayDiverged     = fmt.Errorf("replay diverged from the record")
+	ErrGameOver           = fmt.Errorf("game is over")
+	ErrNoSeed             = fmt.Errorf("game was not created with a seed")
+	ErrSnapshotVersion    = fmt.Errorf("unsupported snapshot version")
+	ErrSnapshotPlayers    = fmt.Errorf("players do not match the snapshot")
+	ErrInvalidRuleSet     = fmt.Errorf("invalid rule set")
+	ErrPlayerCount        = fmt.Errorf("unsupported number of players")
+	ErrPlayerName         = fmt.Errorf("player name is already taken")
+	ErrPlayerPanic        = fmt.Errorf("player panicked")
 )
+
+// PlayerError is returned when a Player returns an error or an invalid decision.
+// It wraps one of the errors above or the Player's own error.
+type PlayerError struct {
+	// Player is the name of the misbehaving Player
+	Player string
+	Err    error
+}
+
+// Error returns the error message
+func (e *PlayerError) Error() string {
+	return fmt.Sprintf("player %s: %s", e.Player, e.Err)
+}
+
+// Unwrap returns the wrapped error
+func (e *PlayerError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,225 @@
+package game
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+)
+
+// EventType is the type of an Event
+type EventType string
+
+// EventTypes
+const (
+	// EventGameStart is the first Event of a Game. It lists the players in seat order.
+	EventGameStart EventType = "game-start"
+	// EventDeal is emitted for each player when ArtPieces are dealt at the start of a Phase.
+	EventDeal EventType = "deal"
+	// EventTurnSkipped is emitted when a player does not hold an Auction on their turn.
+	EventTurnSkipped EventType = "turn-skipped"
+	// EventTimeout is emitted when a player misses the deadline of a decision. The Game makes the decision for them.
+	EventTimeout EventType = "timeout"
+	// EventAuctionStart is emitted when an auctioneer puts ArtPieces up for Auction.
+	EventAuctionStart EventType = "auction-start"
+	// EventSetPrice is emitted when the auctioneer sets the price of a set-price Auction.
+	EventSetPrice EventType = "set-price"
+	// EventBid is emitted for every valid Bid, including rejected offers in a set-price Auction.
+	EventBid EventType = "bid"
+	// EventPass is emitted when a bidder declines the price of a set-price Auction, and when a misbehaving player's
+	// decision in an Auction counts as a pass under the MisbehaviorPolicy. A missed deadline is an EventTimeout instead.
+	EventPass EventType = "pass"
+	// EventWithdraw is emitted when a bidder withdraws from an open Auction.
+	EventWithdraw EventType = "withdraw"
+	// EventClose is emitted when the auctioneer closes an open Auction. Its Bid holds the standing bid.
+	EventClose EventType = "close"
+	// EventAuctionResult is emitted when an Auction is over, or when its ArtPieces ended the Phase.
+	EventAuctionResult EventType = "auction-result"
+	// EventTransfer is emitted whenever money moves between players or the bank.
+	EventTransfer EventType = "transfer"
+	// EventPhaseEnd is emitted after players are paid out at the end of a Phase.
+	EventPhaseEnd EventType = "phase-end"
+	// EventGameEnd is the last Event of a Game. It holds the final scores.
+	EventGameEnd EventType = "game-end"
+)
+
+// TransferReasons explain why money was transferred
+const (
+	TransferReasonStartingMoney = "starting-money"
+	TransferReasonPurchase      = "purchase"
+	TransferReasonPayout        = "payout"
+)
+
+// Event is a single entry in the ordered record of a Game. Exactly one of
+// the payload fields is set, matching the Type.
+type Event struct {
+	// Seq is the position of the Event in the Game, starting at 0
+	Seq   int         `json:"seq"`
+	Type  EventType   `json:"type"`
+	Phase PhaseNumber `json:"phase"`
+
+	GameStart     *GameStartEvent     `json:"game_start,omitempty"`
+	Deal          *DealEvent          `json:"deal,omitempty"`
+	TurnSkipped   *TurnSkippedEvent   `json:"turn_skipped,omitempty"`
+	Timeout       *TimeoutEvent       `json:"timeout,omitempty"`
+	AuctionStart  *AuctionStartEvent  `json:"auction_start,omitempty"`
+	Bid           *BidEvent           `json:"bid,omitempty"`
+	AuctionResult *AuctionResultEvent `json:"auction_result,omitempty"`
+	Transfer      *TransferEvent      `json:"transfer,omitempty"`
+	PhaseEnd      *PhaseEndEvent      `json:"phase_end,omitempty"`
+	GameEnd       *GameEndEvent       `json:"game_end,omitempty"`
+}
+
+// GameStartEvent lists the players in seat order, the Game's seed and its MisbehaviorPolicy
+type GameStartEvent struct {
+	Players           []string          `json:"players"`
+	Seed              int64             `json:"seed"`
+	MisbehaviorPolicy MisbehaviorPolicy `json:"misbehavior_policy"`
+	// Rules are the RuleSet of the Game. Records without them were played with the official rules.
+	Rules *RuleSet `json:"rules,omitempty"`
+}
+
+// DealEvent holds the ArtPieces dealt to a player
+type DealEvent struct {
+	Player    string      `json:"player"`
+	ArtPieces []*ArtPiece `json:"art_pieces"`
+}
+
+// TurnSkippedEvent holds the player who did not hold an Auction on their turn
+type TurnSkippedEvent struct {
+	Player string `json:"player"`
+	// Forfeited is true if the player was skipped because they forfeited
+	Forfeited bool `json:"forfeited"`
+	// EmptyHand is true if the player was skipped because they have no ArtPieces left
+	EmptyHand bool `json:"empty_hand"`
+}
+
+// TimeoutEvent holds the decision a player missed the deadline of
+type TimeoutEvent struct {
+	Player   string   `json:"player"`
+	Decision Decision `json:"decision"`
+}
+
+// AuctionStartEvent holds the ArtPieces put up for Auction
+type AuctionStartEvent struct {
+	// Auctioneer is the player who conducts the Auction
+	Auctioneer     string      `json:"auctioneer"`
+	Type           AuctionType `json:"auction_type"`
+	ArtPiece       *ArtPiece   `json:"art_piece"`
+	SecondArtPiece *ArtPiece   `json:"second_art_piece,omitempty"`
+	// DoublePlayedBy is the player who played the double ArtPiece, if another player
+	// added the SecondArtPiece and became the Auctioneer
+	DoublePlayedBy string `json:"double_played_by,omitempty"`
+}
+
+// BidEvent holds a bid or a pass
+type BidEvent struct {
+	Bidder string `json:"bidder"`
+	Value  int    `json:"value"`
+	// Winning is true if the Bid became the winning Bid of the Auction
+	Winning bool `json:"winning"`
+}
+
+// AuctionResultEvent holds the outcome of an Auction
+type AuctionResultEvent struct {
+	Auctioneer string      `json:"auctioneer"`
+	ArtPieces  []*ArtPiece `json:"art_pieces"`
+	// Buyer is empty if the ArtPieces ended the Phase and were not sold
+	Buyer string `json:"buyer,omitempty"`
+	Price int    `json:"price"`
+	// EndedPhase is true if the ArtPieces ended the Phase instead of being auctioned
+	EndedPhase bool `json:"ended_phase"`
+}
+
+// TransferEvent holds a movement of money. An empty From or To is the bank.
+type TransferEvent struct {
+	From   string `json:"from,omitempty"`
+	To     string `json:"to,omitempty"`
+	Amount int    `json:"amount"`
+	Reason string `json:"reason"`
+}
+
+// PhaseEndEvent holds the outcome of a Phase
+type PhaseEndEvent struct {
+	// Rankings are the artists in rank order
+	Rankings []Artist `json:"rankings"`
+	// ArtistCounts are the points of each artist, as in Phase.ArtistCounts
+	ArtistCounts map[Artist]int `json:"artist_counts"`
+	// ArtistValues are the cumulative value of one ArtPiece of each artist this Phase
+	ArtistValues map[Artist]int `json:"artist_values"`
+	// Payouts are the money each player was paid for their collection
+	Payouts map[string]int `json:"payouts"`
+	// Money is each player's money after the payouts
+	Money map[string]int `json:"money"`
+}
+
+// GameEndEvent holds the final scores
+type GameEndEvent struct {
+	Scores map[string]int `json:"scores"`
+}
+
+// EventListener is called with every Event as soon as the Game emits it
+type EventListener func(*Event)
+
+// Events returns all Events the Game has emitted so far, in order
+func (g *Game) Events() []*Event {
+	return g.events
+}
+
+// emit records the Event and passes it to the listeners
+func (g *Game) emit(event *Event) {
+	event.Seq = len(g.events)
+	event.Phase = g.CurrentPhase
+	g.events = append(g.events, event)
+	for _, listener := range g.eventListeners {
+		listener(event)
+	}
+}
+
+func (g *Game) recordBid(eventType EventType, bidder *GamePlayer, value int, winning bool) {
+	g.emit(&Event{
+		Type: eventType,
+		Bid: &BidEvent{
+			Bidder:  bidder.Player.Name(),
+			Value:   value,
+			Winning: winning,
+		},
+	})
+}
+
+// WriteJSONLines writes the Events to w as JSON Lines, one Event per line
+func WriteJSONLines(w io.Writer, events []*Event) error {
+	encoder := json.NewEncoder(w)
+	for _, event := range events {
+		if err := encoder.Encode(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadJSONLines reads Events written by WriteJSONLines
+func ReadJSONLines(r io.Reader) ([]*Event, error) {
+	events := []*Event{}
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		event := &Event{}
+		if err := json.Unmarshal(scanner.Bytes(), event); err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, scanner.Err()
+}
+
+// JSONLinesListener returns an EventListener that writes each Event to w as a JSON line.
+// Write errors are ignored so that a broken log never stops a Game.
+func JSONLinesListener(w io.Writer) EventListener {
+	encoder := json.NewEncoder(w)
+	return func(event *Event) {
+		_ = encoder.Encode(event)
+	}
+}
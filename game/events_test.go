@@ -0,0 +1,268 @@
+package game_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/SachinMeier/modern-art.git/game"
+	"github.com/SachinMeier/modern-art.git/game/players"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+func TestEventsSuite(t *testing.T) {
+	suite.Run(t, new(EventsTestSuite))
+}
+
+type EventsTestSuite struct {
+	suite.Suite
+	testCtx    context.Context
+	cancelFunc context.CancelFunc
+}
+
+func (suite *EventsTestSuite) SetupSuite() {}
+
+func (suite *EventsTestSuite) SetupTest() {
+	suite.testCtx, suite.cancelFunc = context.WithCancel(context.Background())
+}
+
+func (suite *EventsTestSuite) TearDownTest() {
+	suite.cancelFunc()
+}
+
+func (suite *EventsTestSuite) TearDownSuite() {}
+
+func (suite *EventsTestSuite) Test_Events() {
+	// 1. Test that a Game records its events in order from start to end
+	{
+		listened := []*game.Event{}
+		ng := suite.newGame(game.WithEventListener(func(event *game.Event) {
+			listened = append(listened, event)
+		}))
+		result, err := ng.Start()
+		suite.NoError(err)
+
+		events := ng.Events()
+		suite.Equal(events, listened)
+		suite.Equal(game.EventGameStart, events[0].Type)
+		suite.Equal([]string{"dummy-0", "dummy-1", "dummy-2", "dummy-3"}, events[0].GameStart.Players)
+		suite.Equal(int64(7), events[0].GameStart.Seed)
+		last := events[len(events)-1]
+		suite.Equal(game.EventGameEnd, last.Type)
+		suite.Equal(result.Scores(), last.GameEnd.Scores)
+
+		phaseEnds := 0
+		for i, event := range events {
+			suite.Equal(i, event.Seq)
+			if event.Type == game.EventPhaseEnd {
+				suite.Equal(game.PhaseNumber(phaseEnds), event.Phase)
+				phaseEnds++
+			}
+		}
+		suite.Equal(len(game.AllPhases()), phaseEnds)
+	}
+
+	// 2. Test that every auction start is followed by its bids and a result
+	{
+		ng := suite.newGame()
+		_, err := ng.Start()
+		suite.NoError(err)
+
+		var started *game.AuctionStartEvent
+		auctions := 0
+		for _, event := range ng.Events() {
+			switch event.Type {
+			case game.EventAuctionStart:
+				suite.Nil(started)
+				started = event.AuctionStart
+			case game.EventBid, game.EventPass:
+				suite.NotNil(started)
+				suite.NotEmpty(event.Bid.Bidder)
+			case game.EventAuctionResult:
+				suite.NotNil(started)
+				suite.Equal(started.Auctioneer, event.AuctionResult.Auctioneer)
+				if !event.AuctionResult.EndedPhase {
+					suite.NotEmpty(event.AuctionResult.Buyer)
+				}
+				started = nil
+				auctions++
+			}
+		}
+		suite.Nil(started)
+		pastAuctions := 0
+		for _, phase := range ng.PastPhases {
+			pastAuctions += phase.Len()
+		}
+		suite.Equal(pastAuctions, auctions)
+	}
+
+	// 3. Test that the money transfers add up to the final scores
+	{
+		ng := suite.newGame()
+		result, err := ng.Start()
+		suite.NoError(err)
+
+		money := make(map[string]int)
+		for _, event := range ng.Events() {
+			if event.Type != game.EventTransfer {
+				continue
+			}
+			suite.True(event.Transfer.From != "" || event.Transfer.To != "")
+			money[event.Transfer.From] -= event.Transfer.Amount
+			money[event.Transfer.To] += event.Transfer.Amount
+		}
+		for name, score := range result.Scores() {
+			suite.Equal(score, money[name])
+		}
+	}
+}
+
+func (suite *EventsTestSuite) Test_JSONLines() {
+	// 1. Test that events survive a round trip through JSON Lines
+	{
+		ng := suite.newGame()
+		_, err := ng.Start()
+		suite.NoError(err)
+
+		var buf bytes.Buffer
+		suite.NoError(game.WriteJSONLines(&buf, ng.Events()))
+		suite.Equal(len(ng.Events()), bytes.Count(buf.Bytes(), []byte("\n")))
+
+		events, err := game.ReadJSONLines(&buf)
+		suite.NoError(err)
+		suite.Equal(ng.Events(), events)
+	}
+
+	// 2. Test that the JSONLinesListener writes the same log as WriteJSONLines
+	{
+		var listened bytes.Buffer
+		ng := suite.newGame(game.WithEventListener(game.JSONLinesListener(&listened)))
+		_, err := ng.Start()
+		suite.NoError(err)
+
+		var written bytes.Buffer
+		suite.NoError(game.WriteJSONLines(&written, ng.Events()))
+		suite.Equal(written.String(), listened.String())
+	}
+
+	// 3. Test that malformed lines are rejected
+	{
+		_, err := game.ReadJSONLines(bytes.NewBufferString("{\"seq\":0}\nnot json\n"))
+		suite.Error(err)
+	}
+}
+
+func (suite *EventsTestSuite) Test_PublicEvents() {
+	// 1. Test that the public Events leave out deals, the seed and money, and hold blind bids back until the result
+	{
+		rules := game.DefaultRuleSet()
+		var ng *game.Game
+		public := []*game.Event{}
+		ng = suite.newGame(game.WithRuleSet(rules), game.WithEventListener(game.PublicEvents(rules, func(event *game.Event) {
+			// NewGame emits the first Events before it returns
+			if ng != nil && blindBids(ng.Events())[event.Seq] {
+				suite.Equal(game.EventAuctionResult, ng.Events()[len(ng.Events())-1].Type, "blind bid before the result")
+			}
+			public = append(public, event)
+		})))
+		_, err := ng.Start()
+		suite.NoError(err)
+
+		suite.Equal(int64(0), public[0].GameStart.Seed)
+		suite.Equal(int64(7), ng.Events()[0].GameStart.Seed)
+		blind, phaseEnds := 0, 0
+		for i, event := range public {
+			switch event.Type {
+			case game.EventDeal, game.EventTransfer:
+				suite.Fail("private event", event.Type)
+			case game.EventBid:
+				if blindBids(ng.Events())[event.Seq] {
+					blind++
+				}
+			case game.EventPhaseEnd:
+				suite.Nil(event.PhaseEnd.Money)
+				suite.NotEmpty(event.PhaseEnd.ArtistValues)
+				phaseEnds++
+			}
+			if i > 0 {
+				suite.NotEqual(public[i-1].Seq, event.Seq)
+			}
+		}
+		suite.Greater(blind, 0)
+		suite.Equal(len(game.AllPhases()), phaseEnds)
+		for _, event := range ng.Events() {
+			if event.Type == game.EventPhaseEnd {
+				suite.NotNil(event.PhaseEnd.Money)
+			}
+		}
+	}
+
+	// 2. Test that blind bids are left out if the rules do not reveal them
+	{
+		rules := game.DefaultRuleSet()
+		rules.RevealBlindBids = false
+		public := []*game.Event{}
+		ng := suite.newGame(game.WithRuleSet(rules), game.WithEventListener(game.PublicEvents(rules, func(event *game.Event) {
+			public = append(public, event)
+		})))
+		_, err := ng.Start()
+		suite.NoError(err)
+
+		suite.NotEmpty(blindBids(ng.Events()))
+		for _, event := range public {
+			suite.False(blindBids(ng.Events())[event.Seq])
+		}
+	}
+
+	// 3. Test that only deals and the seed are left out when money is open
+	{
+		rules := game.DefaultRuleSet()
+		rules.OpenMoney = true
+		public := []*game.Event{}
+		ng := suite.newGame(game.WithRuleSet(rules), game.WithEventListener(game.PublicEvents(rules, func(event *game.Event) {
+			public = append(public, event)
+		})))
+		_, err := ng.Start()
+		suite.NoError(err)
+
+		deals := 0
+		for _, event := range ng.Events() {
+			if event.Type == game.EventDeal {
+				deals++
+			}
+		}
+		suite.Greater(deals, 0)
+		suite.Equal(len(ng.Events())-deals, len(public))
+		suite.Equal(int64(0), public[0].GameStart.Seed)
+		suite.NotNil(public[len(public)-2].PhaseEnd.Money)
+	}
+}
+
+// helpers
+
+// newGame creates a seeded Game of DummyPlayers, so its events are reproducible
+func (suite *EventsTestSuite) newGame(opts ...game.GameOption) *game.Game {
+	ps := make([]game.Player, 4)
+	for i := range ps {
+		ps[i] = players.NewDummyPlayer(fmt.Sprintf("dummy-%d", i))
+	}
+	return mustNewGame(&suite.Suite, ps, append([]game.GameOption{game.WithSeed(7)}, opts...)...)
+}
+
+// blindBids returns the Seqs of the bids and passes of blind Auctions among the Events
+func blindBids(events []*game.Event) map[int]bool {
+	seqs := make(map[int]bool)
+	blind := false
+	for _, event := range events {
+		switch event.Type {
+		case game.EventAuctionStart:
+			auction := &game.Auction{ArtPiece: event.AuctionStart.ArtPiece, SecondArtPiece: event.AuctionStart.SecondArtPiece}
+			blind = auction.ArtPieceAuctionType() == game.AuctionTypeBlind
+		case game.EventBid, game.EventPass:
+			if blind {
+				seqs[event.Seq] = true
+			}
+		}
+	}
+	return seqs
+}
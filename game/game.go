@@ -1,5 +1,12 @@
 package game
 
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+)
+
 // Game is the main struct for the game.
 // It holds all the state of the game.
 type Game struct {
@@ -8,112 +15,430 @@ type Game struct {
 	Players      PlayerOrder
 	// ArtPieces is the Deck to be dealt out
 	ArtPieces []*ArtPiece
+
+	// rules are the RuleSet the Game is played with
+	rules *RuleSet
+	// misbehaviorPolicy decides what happens when a Player returns an error or an invalid decision
+	misbehaviorPolicy MisbehaviorPolicy
+	// ctx cancels the Game. It is nil if the Game cannot be cancelled.
+	ctx context.Context
+	// decisionTimeout is the time a Player has for each decision. It is 0 if there is no limit.
+	decisionTimeout time.Duration
+	// phase is the Phase in progress. It is nil between Phases.
+	phase *Phase
+	// skippedTurns counts the turns in a row in which no Auction was held
+	skippedTurns int
+
+	// seed is the seed of rand. It is 0 if the Game was given a rand.Source
+	seed int64
+	// source counts the draws from the seed, so the Game can be snapshotted.
+	// It is nil if the Game was given a rand.Source
+	source *countingSource
+	// rand is the source of all randomness in the Game
+	rand *rand.Rand
+	// events is the ordered record of everything that happened in the Game
+	events         []*Event
+	eventListeners []EventListener
 }
 
-// NewGame creates a new Game
-func NewGame(players []Player) *Game {
-	if len(players) > MaxPlayers {
-		panic("too many players")
-	}
-	playerOrder := NewPlayerOrder(players)
+// NewGame creates a new Game. It returns ErrPlayerCount if the RuleSet does not support the
+// number of players, and ErrPlayerName if two players share a name.
+func NewGame(players []Player, opts ...GameOption) (*Game, error) {
 	g := &Game{
 		CurrentPhase: Phase1,
 		PastPhases:   []*Phase{},
-		Players:      playerOrder,
 		ArtPieces:    NewArtPieceDeck(),
+		rules:        DefaultRuleSet(),
+	}
+	WithSeed(randomSeed())(g)
+	for _, opt := range opts {
+		opt(g)
+	}
+	if err := g.rules.Validate(); err != nil {
+		return nil, err
+	}
+	if err := g.rules.ValidatePlayers(len(players)); err != nil {
+		return nil, err
 	}
+	if g.rules.DummyCollector {
+		players = append(append([]Player{}, players...), newCollector())
+	}
+	if err := validatePlayerNames(players); err != nil {
+		return nil, err
+	}
+	g.Players = NewPlayerOrder(players)
 
+	// each RandPlayer gets its own source derived from the Game's, so that the
+	// randomness of one Player does not change the cards dealt or other Players' decisions.
+	// A source is derived for every seat, so the cards dealt do not depend on which Players are RandPlayers
 	for _, player := range g.Players {
-		g.givePlayerMoney(player, StartingMoney)
+		playerSeed := g.rand.Int63()
+		if randPlayer, ok := player.Player.(RandPlayer); ok {
+			randPlayer.SetRand(rand.New(rand.NewSource(playerSeed)))
+		}
 	}
 
-	return g
+	names := make([]string, 0, len(g.Players))
+	for _, player := range g.Players {
+		// the dummy collector is added by the RuleSet, not by whoever created the Game
+		if !isCollector(player.Player) {
+			names = append(names, player.Player.Name())
+		}
+	}
+	g.emit(&Event{
+		Type:      EventGameStart,
+		GameStart: &GameStartEvent{Players: names, Seed: g.seed, MisbehaviorPolicy: g.misbehaviorPolicy, Rules: g.rules.Copy()},
+	})
+
+	for _, player := range g.Players {
+		g.transferMoney(nil, player, g.rules.StartingMoney, TransferReasonStartingMoney)
+	}
+
+	return g, nil
+}
+
+// validatePlayerNames returns ErrPlayerName if two players share a name
+func validatePlayerNames(players []Player) error {
+	names := make(map[string]bool)
+	for _, player := range players {
+		if names[player.Name()] {
+			return fmt.Errorf("%w: %s", ErrPlayerName, player.Name())
+		}
+		names[player.Name()] = true
+	}
+	return nil
 }
 
-// Start begins the game
-func (g *Game) Start() map[string]int {
+// Start plays the Game to the end and returns its GameResult
+func (g *Game) Start() (*GameResult, error) {
 	for {
-		if gameOver := g.DoPhase(); gameOver {
+		gameOver, err := g.DoPhase()
+		if err != nil {
+			return nil, err
+		}
+		if gameOver {
 			break
 		}
 	}
-	return g.CalculateScores()
+	g.emit(&Event{Type: EventGameEnd, GameEnd: &GameEndEvent{Scores: g.CalculateScores()}})
+	// each Player is given their own GameResult, so that none can change another's
+	for _, player := range g.Players {
+		if resultPlayer, ok := player.Player.(ResultPlayer); ok {
+			resultPlayer.HandleGameResult(g.Result())
+		}
+	}
+	return g.Result(), nil
 }
 
-// DoPhase does a phase of the game. Returns true if game is over
-func (g *Game) DoPhase() bool {
-	// dealCards uses CurrentPhase to determine how many cards to deal
-	g.DealArtPieces()
-	phase := NewPhase()
+// DoPhase does a phase of the game, or the rest of the Phase in progress. Returns true if game is over
+func (g *Game) DoPhase() (bool, error) {
 	for {
-		if isOver := g.doTurn(phase); isOver {
-			break
+		phaseOver, err := g.DoTurn()
+		if err != nil {
+			return false, err
 		}
+		if phaseOver {
+			return g.GameOver(), nil
+		}
+	}
+}
+
+// DoTurn does the next turn of the Game, dealing a new Phase if none is in progress.
+// Returns true if the turn ended the Phase. The Game can be snapshotted between turns.
+func (g *Game) DoTurn() (bool, error) {
+	if g.GameOver() {
+		return true, ErrGameOver
+	}
+	if g.phase == nil {
+		// dealCards uses CurrentPhase to determine how many cards to deal
+		g.DealArtPieces()
+		g.phase = g.rules.NewPhase()
+		g.skippedTurns = 0
 	}
-	g.PastPhases = append(g.PastPhases, phase)
+	// a Phase can be dealt no ArtPieces at all, so there may be no turn to take
+	isOver, skipped := false, false
+	if !g.Players.HandsEmpty() {
+		var err error
+		isOver, skipped, err = g.doTurn(g.phase)
+		if err != nil {
+			return false, err
+		}
+	}
+	if skipped {
+		g.skippedTurns++
+	} else {
+		g.skippedTurns = 0
+	}
+	// if every player in a row skips their turn, no one can end the phase.
+	// If every hand is empty, the phase ends after the last ArtPiece was auctioned
+	if !isOver && g.skippedTurns < len(g.Players) && !g.Players.HandsEmpty() {
+		return false, nil
+	}
+	g.PastPhases = append(g.PastPhases, g.phase)
+	g.phase = nil
 	// PayoutPlayer uses CurrentPhase as the index of the phase in PastPhases
 	// so we only increment it after payout is done
 	g.PayoutPlayers()
-	if gameOver := g.NextPhase(); gameOver {
-		return true
-	}
-	return false
+	g.NextPhase()
+	return true, nil
 }
 
-// doTurn does a turn of a Phase
-func (g *Game) doTurn(phase *Phase) bool {
+// doTurn does a turn of a Phase. Returns true if the phase is over
+// and whether the turn was skipped because the player did not hold an Auction.
+func (g *Game) doTurn(phase *Phase) (bool, bool, error) {
 	auctioneer := g.Players.Pop()
+	// push the auctioneer to the end right away. This allows them to Bid on their own
+	// Auction and ensures all auctioneers are remembered for payouts & scoring
+	g.Players.Push(auctioneer)
+	if auctioneer.Forfeited {
+		g.emit(&Event{Type: EventTurnSkipped, TurnSkipped: &TurnSkippedEvent{Player: auctioneer.Player.Name(), Forfeited: true}})
+		return false, true, nil
+	}
+	// a player with no ArtPieces left cannot hold an Auction
+	if len(auctioneer.Hand) == 0 {
+		g.emit(&Event{Type: EventTurnSkipped, TurnSkipped: &TurnSkippedEvent{Player: auctioneer.Player.Name(), EmptyHand: true}})
+		return false, true, nil
+	}
 
 	// Ask the auctioneer whose turn it is to hold an auction
-	auction, err := auctioneer.Player.HoldAuction()
+	auction, err := g.requestAuction(auctioneer)
 	if err != nil {
-		// TODO: handle error? or just crash game
-		panic(err)
+		return false, false, err
+	}
+	// the auctioneer passed or forfeited
+	if auction == nil {
+		g.emit(&Event{Type: EventTurnSkipped, TurnSkipped: &TurnSkippedEvent{Player: auctioneer.Player.Name()}})
+		return false, true, nil
 	}
-	// TODO: validate auction and maybe even set auction.Auctioneer
-	// remove the ArtPiece from the auctioneer's hand
-	if err := auctioneer.RemoveArtPieceFromHand(auction.ArtPiece); err != nil {
-		panic(err)
+	// remove the ArtPieces from the auctioneer's hand. requestAuction ensures they are there
+	_ = auctioneer.RemoveArtPieceFromHand(auction.ArtPiece)
+	doublePlayedBy := ""
+	if auction.IsDouble() {
+		player := auctioneer
+		// the Player who adds the second ArtPiece conducts the Auction
+		auctioneer, err = g.prepareDoubleAuction(phase, auctioneer, auction)
+		if err != nil {
+			return false, false, err
+		}
+		if auctioneer != player {
+			doublePlayedBy = player.Player.Name()
+		}
 	}
-	// If the auctioned piece ends the round, don't do the auction
+	g.emit(&Event{
+		Type: EventAuctionStart,
+		AuctionStart: &AuctionStartEvent{
+			Auctioneer:     auctioneer.Player.Name(),
+			Type:           auction.Type,
+			ArtPiece:       auction.ArtPiece,
+			SecondArtPiece: auction.SecondArtPiece,
+			DoublePlayedBy: doublePlayedBy,
+		},
+	})
+	// If the auctioned pieces end the round, don't do the auction
 	phase.AddAuction(auction)
 	if phase.IsOver() {
 		// set auction Bid to nil to indicate no winner. This is necessary
 		// for fixed-price auctions where the auctioneer bids first. Then
 		// add it to the phase to allow for payouts & scoring
 		auction.WinningBid = nil
-		// we need to push the auctioneer back on the end of the queue
-		// to ensure all auctioneers are remembered for payouts & scoring
-		g.Players.Push(auctioneer)
-		return true
+		g.emit(&Event{
+			Type: EventAuctionResult,
+			AuctionResult: &AuctionResultEvent{
+				Auctioneer: auctioneer.Player.Name(),
+				ArtPieces:  auction.ArtPieces(),
+				EndedPhase: true,
+			},
+		})
+		return true, false, nil
 	}
 
-	// push Player to end to allow them to Bid on their own Auction
-	// TODO: in the case of a fixed-price auction, Auctioneer bids first, so this
-	// should not happen until after the bids are taken in.
-	g.Players.Push(auctioneer)
-
-	// Copy the auctioneer order to allow each player to bid
-	auctionBidders := g.Players.Copy()
-	auction.Run(auctionBidders)
+	// every player bids, starting left of the auctioneer, who bids last
+	auctionBidders := g.Players.BiddingOrder(auctioneer)
+	if auction.IsDouble() && auction.SecondArtPiece == nil {
+		// no one added a second ArtPiece, so the auctioneer gets the double for free
+		auction.WinningBid = NewBid(auctioneer.Player, 0)
+	} else if err := auction.Run(g, auctionBidders.Active()); err != nil {
+		return false, false, err
+	}
+	g.emit(&Event{
+		Type: EventAuctionResult,
+		AuctionResult: &AuctionResultEvent{
+			Auctioneer: auctioneer.Player.Name(),
+			ArtPieces:  auction.ArtPieces(),
+			Buyer:      auction.WinningBid.Bidder.Name(),
+			Price:      auction.WinningBid.Value,
+		},
+	})
 
 	// notify all auctioneers of the result
 	for _, bidder := range auctionBidders {
-		bidder.Player.HandleAuctionResult(auction)
+		bidder.Player.HandleAuctionResult(g.publicAuction(auction))
 	}
 
 	buyer := g.LookupGamePlayer(auction.WinningBid.Bidder.Name())
-	// debit money from the buyer
-	g.givePlayerMoney(buyer, -auction.WinningBid.Value)
-	// give buyer the art piece
-	buyer.Collection = append(buyer.Collection, auction.ArtPiece)
+	// give buyer the art pieces
+	buyer.Collection = append(buyer.Collection, auction.ArtPieces()...)
 	// if the auctioneer bought their own painting, money goes to the bank
 	// else give money to the auctioneer
+	var seller *GamePlayer
 	if auctioneer.Player.Name() != auction.WinningBid.Bidder.Name() {
-		g.givePlayerMoney(auctioneer, auction.WinningBid.Value)
+		seller = auctioneer
 	}
+	g.transferMoney(buyer, seller, auction.WinningBid.Value, TransferReasonPurchase)
 	// round can't end after an auction
-	return false
+	return false, false, nil
+}
+
+// requestAuction asks the auctioneer to hold an Auction and validates it, applying the
+// MisbehaviorPolicy if it is invalid. Returns a nil Auction if the auctioneer passes.
+func (g *Game) requestAuction(auctioneer *GamePlayer) (*Auction, error) {
+	for attempt := 0; ; attempt++ {
+		ctx, cancel := g.decisionContext()
+		auction, err := auctioneer.decider().HoldAuctionContext(ctx, g.view(auctioneer))
+		cancel()
+		if timedOut, err := g.checkTimeout(auctioneer, DecisionHoldAuction, err); err != nil {
+			return nil, err
+		} else if timedOut {
+			return g.randomAuction(auctioneer), nil
+		}
+		if err == nil {
+			err = validateAuction(auctioneer, auction)
+		}
+		if err == nil {
+			auction.Auctioneer = auctioneer.Player
+			// the AuctionType is dictated by the ArtPiece
+			auction.Type = auction.ArtPiece.AuctionType
+			return auction, nil
+		}
+		if retry, err := g.misbehaviorPolicy.handle(auctioneer, attempt, err); !retry {
+			return nil, err
+		}
+	}
+}
+
+// randomAuction puts a random ArtPiece from the auctioneer's hand up for Auction.
+// Returns nil if their hand is empty.
+func (g *Game) randomAuction(auctioneer *GamePlayer) *Auction {
+	if len(auctioneer.Hand) == 0 {
+		return nil
+	}
+	artPiece := auctioneer.Hand[g.rand.Intn(len(auctioneer.Hand))]
+	return NewAuction(auctioneer.Player, artPiece, nil)
+}
+
+// validateAuction ensures the auctioneer holds the ArtPieces of the Auction and that
+// its Type matches them
+func validateAuction(auctioneer *GamePlayer, auction *Auction) error {
+	if auction == nil || auction.ArtPiece == nil {
+		return ErrInvalidAuction
+	}
+	if !auctioneer.HasArtPiece(auction.ArtPiece) {
+		return ErrArtPieceNotFound
+	}
+	if auction.SecondArtPiece != nil {
+		if err := validateSecondArtPiece(auctioneer, auction, auction.SecondArtPiece); err != nil {
+			return err
+		}
+	}
+	return validateAuctionType(auction)
+}
+
+// validateSecondArtPiece ensures the SecondArtPiece of a double Auction is held by
+// the player adding it and is a non-double by the same Artist
+func validateSecondArtPiece(player *GamePlayer, auction *Auction, artPiece *ArtPiece) error {
+	if !auction.IsDouble() || artPiece.IsDouble() || artPiece.Artist != auction.ArtPiece.Artist {
+		return ErrInvalidDouble
+	}
+	if !player.HasArtPiece(artPiece) {
+		return ErrArtPieceNotFound
+	}
+	return nil
+}
+
+// prepareDoubleAuction finds the SecondArtPiece for a double Auction and returns the GamePlayer
+// who conducts it. The auctioneer may have attached a SecondArtPiece already. Otherwise, the
+// other players are offered to add one in seat order. The first player to add one becomes
+// the auctioneer and play continues after them.
+func (g *Game) prepareDoubleAuction(phase *Phase, auctioneer *GamePlayer, auction *Auction) (*GamePlayer, error) {
+	// if the double ArtPiece ends the phase on its own, no second ArtPiece is played. An attached
+	// one never left the auctioneer's hand, so the auctioneer is given it back
+	if phase.EndsPhase(auction.ArtPiece.Artist) {
+		if auction.SecondArtPiece != nil {
+			auctioneer.Player.AddArtPieces([]*ArtPiece{auction.SecondArtPiece})
+			auction.SecondArtPiece = nil
+		}
+		return auctioneer, nil
+	}
+
+	if auction.SecondArtPiece != nil {
+		g.addSecondArtPiece(auctioneer, auction, auction.SecondArtPiece)
+		return auctioneer, nil
+	}
+
+	// the other players are offered the double in seat order starting left of the auctioneer
+	others := g.Players.BiddingOrder(auctioneer)
+	others = others[:len(others)-1]
+	for _, player := range others.Active() {
+		artPiece, err := g.requestSecondArtPiece(player, auction)
+		if err != nil {
+			return nil, err
+		}
+		if artPiece == nil {
+			continue
+		}
+		g.addSecondArtPiece(player, auction, artPiece)
+		auction.Auctioneer = player.Player
+		g.Players.RotateTo(player)
+		return player, nil
+	}
+
+	return auctioneer, nil
+}
+
+// requestSecondArtPiece offers the player to add a SecondArtPiece to a double Auction,
+// applying the MisbehaviorPolicy if they add an invalid one. Returns nil if they decline.
+func (g *Game) requestSecondArtPiece(player *GamePlayer, auction *Auction) (*ArtPiece, error) {
+	for attempt := 0; ; attempt++ {
+		ctx, cancel := g.decisionContext()
+		artPiece, err := player.decider().OfferDoubleContext(ctx, g.view(player), g.publicAuction(auction))
+		cancel()
+		if timedOut, err := g.checkTimeout(player, DecisionOfferDouble, err); timedOut || err != nil {
+			return nil, err
+		}
+		if err == nil && artPiece == nil {
+			return nil, nil
+		}
+		if err == nil {
+			err = validateSecondArtPiece(player, auction, artPiece)
+		}
+		if err == nil {
+			return artPiece, nil
+		}
+		if retry, err := g.misbehaviorPolicy.handle(player, attempt, err); !retry {
+			return nil, err
+		}
+	}
+}
+
+// addSecondArtPiece adds a validated SecondArtPiece to a double Auction
+// and removes it from the hand of the player who added it
+func (g *Game) addSecondArtPiece(player *GamePlayer, auction *Auction, artPiece *ArtPiece) {
+	_ = player.RemoveArtPieceFromHand(artPiece)
+	auction.SecondArtPiece = artPiece
+	// a double Auction is run with the AuctionType of the SecondArtPiece
+	auction.Type = artPiece.AuctionType
+}
+
+// Rules returns a copy of the RuleSet the Game is played with
+func (g *Game) Rules() *RuleSet {
+	return g.rules.Copy()
+}
+
+// Seed returns the seed the Game's randomness was created from. Playing the same
+// Players with the same seed produces the same Game.
+// It is 0 if the Game was given a rand.Source with WithRandSource.
+func (g *Game) Seed() int64 {
+	return g.seed
 }
 
 // NextPhase increments the CurrentPhase
@@ -136,12 +461,14 @@ func (g *Game) LookupGamePlayer(name string) *GamePlayer {
 
 // GameOver returns true if the game is over
 func (g *Game) GameOver() bool {
-	return g.CurrentPhase > FinalPhase
+	return int(g.CurrentPhase) >= g.rules.Phases
 }
 
 // PayoutPlayers pays out the players after a concluded Phase
 func (g *Game) PayoutPlayers() {
 	payouts := CumulativePayouts(g.PastPhases)
+	playerPayouts := make(map[string]int)
+	money := make(map[string]int)
 
 	// sum the value of their ArtPiece collection
 	for _, player := range g.Players {
@@ -150,26 +477,45 @@ func (g *Game) PayoutPlayers() {
 			phaseRevenue += payouts[artPiece.Artist]
 		}
 		// give the player the money
-		g.givePlayerMoney(player, phaseRevenue)
+		g.transferMoney(nil, player, phaseRevenue, TransferReasonPayout)
 		// clear collection
 		player.Collection = []*ArtPiece{}
+		playerPayouts[player.Player.Name()] = phaseRevenue
+		money[player.Player.Name()] = player.Money
+	}
+
+	phase := g.PastPhases[len(g.PastPhases)-1]
+	artistCounts := make(map[Artist]int)
+	for artist, count := range phase.ArtistCounts {
+		artistCounts[artist] = count
 	}
+	g.emit(&Event{
+		Type: EventPhaseEnd,
+		PhaseEnd: &PhaseEndEvent{
+			Rankings:     phase.RankedArtists(),
+			ArtistCounts: artistCounts,
+			ArtistValues: payouts,
+			Payouts:      playerPayouts,
+			Money:        money,
+		},
+	})
 }
 
 // DealArtPieces deals ArtPieces to the players
 func (g *Game) DealArtPieces() {
-	piecesToDeal := ArtPiecesPerPhase[len(g.Players)][g.CurrentPhase]
+	piecesToDeal := g.rules.ArtPiecesToDeal(len(g.Players), g.CurrentPhase)
 	for _, player := range g.Players {
 		pieces := make([]*ArtPiece, piecesToDeal, piecesToDeal)
 		for i := 0; i < piecesToDeal; i++ {
 			pieces[i] = g.dealArtPiece()
 		}
 		g.givePlayerArtPieces(player, pieces)
+		g.emit(&Event{Type: EventDeal, Deal: &DealEvent{Player: player.Player.Name(), ArtPieces: pieces}})
 	}
 }
 
 func (g *Game) dealArtPiece() *ArtPiece {
-	artPiece, remaining := pickRandomArtPiece(g.ArtPieces)
+	artPiece, remaining := pickRandomArtPiece(g.rand, g.ArtPieces)
 	g.ArtPieces = remaining
 	return artPiece
 }
@@ -180,16 +526,34 @@ func (g *Game) givePlayerMoney(player *GamePlayer, amount int) {
 	player.Player.MoveMoney(amount)
 }
 
+// transferMoney moves money from one player to another and records it.
+// A nil player is the bank.
+func (g *Game) transferMoney(from, to *GamePlayer, amount int, reason string) {
+	transfer := &TransferEvent{Amount: amount, Reason: reason}
+	if from != nil {
+		g.givePlayerMoney(from, -amount)
+		transfer.From = from.Player.Name()
+	}
+	if to != nil {
+		g.givePlayerMoney(to, amount)
+		transfer.To = to.Player.Name()
+	}
+	g.emit(&Event{Type: EventTransfer, Transfer: transfer})
+}
+
 func (g *Game) givePlayerArtPieces(player *GamePlayer, artPieces []*ArtPiece) {
 	player.Hand = append(player.Hand, artPieces...)
 	// notify player of new art pieces
 	player.Player.AddArtPieces(artPieces)
 }
 
-// CalculateScores returns a map of player names -> scores
+// CalculateScores returns a map of player names -> scores. The dummy collector has no score.
 func (g *Game) CalculateScores() map[string]int {
 	scores := make(map[string]int)
 	for _, player := range g.Players {
+		if isCollector(player.Player) {
+			continue
+		}
 		scores[player.Player.Name()] = player.Money
 	}
 	return scores
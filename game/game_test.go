@@ -36,41 +36,64 @@ func (suite *GameTestSuite) Test_DealArtPieces() {
 	{
 		playerCt := 4
 		dummies := suite.getNDummyPlayers(playerCt)
-		ng := game.NewGame(dummies)
+		ng := mustNewGame(&suite.Suite, dummies)
 		ng.DealArtPieces()
-		p1ct := game.ArtPiecesPerPhase[playerCt][game.Phase1]
+		p1ct := officialRules.ArtPiecesToDeal(playerCt, game.Phase1)
 		for _, gp := range ng.Players {
 			suite.Equal(p1ct, len(gp.Hand))
 		}
 
 		ng.NextPhase()
 		ng.DealArtPieces()
-		p2ct := game.ArtPiecesPerPhase[playerCt][game.Phase2]
+		p2ct := officialRules.ArtPiecesToDeal(playerCt, game.Phase2)
 		for _, gp := range ng.Players {
 			suite.Equal(p1ct+p2ct, len(gp.Hand))
 		}
 
 		ng.NextPhase()
 		ng.DealArtPieces()
-		p3ct := game.ArtPiecesPerPhase[playerCt][game.Phase3]
+		p3ct := officialRules.ArtPiecesToDeal(playerCt, game.Phase3)
 		for _, gp := range ng.Players {
 			suite.Equal(p1ct+p2ct+p3ct, len(gp.Hand))
 		}
 
 		ng.NextPhase()
 		ng.DealArtPieces()
-		p4ct := game.ArtPiecesPerPhase[playerCt][game.Phase4]
+		p4ct := officialRules.ArtPiecesToDeal(playerCt, game.Phase4)
 		for _, gp := range ng.Players {
 			suite.Equal(p1ct+p2ct+p3ct+p4ct, len(gp.Hand))
 		}
 	}
+
+	// 2. Test that every card is dealt at most once and dealt and remaining cards make up the deck
+	{
+		for seed := int64(1); seed <= 20; seed++ {
+			ng := mustNewGame(&suite.Suite, suite.getNDummyPlayers(5), game.WithSeed(seed))
+			ng.DealArtPieces()
+			ng.NextPhase()
+			ng.DealArtPieces()
+
+			names := make([]string, 0)
+			for _, gp := range ng.Players {
+				names = append(names, handNames(gp)...)
+			}
+			seen := make(map[string]bool)
+			for _, name := range names {
+				suite.False(seen[name], "seed %d dealt %s twice", seed, name)
+				seen[name] = true
+			}
+			names = append(names, handNames(&game.GamePlayer{Hand: ng.ArtPieces})...)
+			deck := handNames(&game.GamePlayer{Hand: game.NewArtPieceDeck()})
+			suite.ElementsMatch(deck, names)
+		}
+	}
 }
 
 func (suite *GameTestSuite) Test_GameOver() {
 	// 1. Test that the game ends when the last phase is over
 	playerCt := 4
 	dummies := suite.getNDummyPlayers(playerCt)
-	ng := game.NewGame(dummies)
+	ng := mustNewGame(&suite.Suite, dummies)
 	for range game.AllPhases() {
 		suite.False(ng.GameOver())
 		ng.NextPhase()
@@ -81,33 +104,34 @@ func (suite *GameTestSuite) Test_GameOver() {
 func (suite *GameTestSuite) Test_Game() {
 	playerCt := 4
 	dummies := suite.getNDummyPlayers(playerCt)
-	ng := game.NewGame(dummies)
+	ng := mustNewGame(&suite.Suite, dummies)
 
 	// check that the players have the correct amount of money
 	for _, player := range ng.Players {
-		suite.Equal(game.StartingMoney, player.Money)
+		suite.Equal(officialRules.StartingMoney, player.Money)
 	}
 	// do phase 1
-	isGameOver := ng.DoPhase()
+	isGameOver, err := ng.DoPhase()
+	suite.NoError(err)
 	suite.False(isGameOver)
 
 	phase1 := ng.PastPhases[0]
 	{
 		// check number of artPieces played
-		phase1ArtCt := phase1.Len()
+		phase1ArtCt := phase1.ArtPieceCount()
 		sumOfArtInHands := 0
 		for _, player := range ng.Players {
 			sumOfArtInHands += len(player.Hand)
 		}
 		// artPieces dealt = artPieces played + artPieces in hands
-		suite.Equal(playerCt*game.ArtPiecesPerPhase[playerCt][game.Phase1], phase1ArtCt+sumOfArtInHands,
-			"artPieces dealt (%d) = played (%d) + in hands (%d)", playerCt*game.ArtPiecesPerPhase[playerCt][game.Phase1],
+		suite.Equal(playerCt*officialRules.ArtPiecesToDeal(playerCt, game.Phase1), phase1ArtCt+sumOfArtInHands,
+			"artPieces dealt (%d) = played (%d) + in hands (%d)", playerCt*officialRules.ArtPiecesToDeal(playerCt, game.Phase1),
 			phase1ArtCt, sumOfArtInHands)
 
 		// check there is one winner for the round
 		winnerCt := 0
 		for _, ct := range ng.PastPhases[0].ArtistCounts {
-			if ct >= game.MaxArtPiecePointsPerPhase {
+			if ct >= officialRules.EndPhasePoints() {
 				winnerCt += 1
 			}
 		}
@@ -118,10 +142,10 @@ func (suite *GameTestSuite) Test_Game() {
 		playerMoney := ng.CalculateScores()
 		expectedMoney := map[string]int{}
 		for _, dummy := range dummies {
-			expectedMoney[dummy.Name()] = game.StartingMoney
+			expectedMoney[dummy.Name()] = officialRules.StartingMoney
 		}
 
-		for _, auction := range phase1.Auctions[:phase1ArtCt-1] {
+		for _, auction := range phase1.Auctions[:phase1.Len()-1] {
 			// should panic if auction.winningBid is nil because that should only be true
 			// for the last auction
 			auctioneer := auction.Auctioneer.Name()
@@ -132,14 +156,16 @@ func (suite *GameTestSuite) Test_Game() {
 			}
 			// subtract what buyer paid
 			expectedMoney[buyer] -= auction.WinningBid.Value
-			// add what payout gave
-			switch auction.ArtPiece.Artist {
-			case p1First:
-				expectedMoney[buyer] += game.RankPayout1
-			case p1Second:
-				expectedMoney[buyer] += game.RankPayout2
-			case p1Third:
-				expectedMoney[buyer] += game.RankPayout3
+			// add what payout gave for each art piece
+			for _, artPiece := range auction.ArtPieces() {
+				switch artPiece.Artist {
+				case p1First:
+					expectedMoney[buyer] += officialRules.RankPayouts[0]
+				case p1Second:
+					expectedMoney[buyer] += officialRules.RankPayouts[1]
+				case p1Third:
+					expectedMoney[buyer] += officialRules.RankPayouts[2]
+				}
 			}
 		}
 
@@ -155,30 +181,31 @@ func (suite *GameTestSuite) Test_Game() {
 
 	p2StartingMoney := ng.CalculateScores()
 	// do phase 2
-	isGameOver = ng.DoPhase()
+	isGameOver, err = ng.DoPhase()
+	suite.NoError(err)
 	suite.False(isGameOver)
 
 	phase2 := ng.PastPhases[1]
 	// check phase 2
 	{
 		suite.Equal(2, len(ng.PastPhases))
-		phase1ArtCt := phase1.Len()
-		phase2ArtCt := phase2.Len()
+		phase1ArtCt := phase1.ArtPieceCount()
+		phase2ArtCt := phase2.ArtPieceCount()
 		sumOfArtInHands := 0
 		for _, player := range ng.Players {
 			sumOfArtInHands += len(player.Hand)
 		}
 		// artPieces dealt = artPieces played + artPieces in hands
-		phase1Dealt := game.ArtPiecesPerPhase[playerCt][game.Phase1]
-		phase2Dealt := game.ArtPiecesPerPhase[playerCt][game.Phase2]
+		phase1Dealt := officialRules.ArtPiecesToDeal(playerCt, game.Phase1)
+		phase2Dealt := officialRules.ArtPiecesToDeal(playerCt, game.Phase2)
 		suite.Equal(playerCt*(phase1Dealt+phase2Dealt), phase1ArtCt+phase2ArtCt+sumOfArtInHands,
-			"artPieces dealt (%d) = played (%d) + in hands (%d)", playerCt*game.ArtPiecesPerPhase[playerCt][game.Phase1],
+			"artPieces dealt (%d) = played (%d) + in hands (%d)", playerCt*officialRules.ArtPiecesToDeal(playerCt, game.Phase1),
 			phase1ArtCt+phase2ArtCt, sumOfArtInHands)
 
 		// check there is one winner for the round
 		winnerCt := 0
 		for _, ct := range phase2.ArtistCounts {
-			if ct >= game.MaxArtPiecePointsPerPhase {
+			if ct >= officialRules.EndPhasePoints() {
 				winnerCt += 1
 			}
 		}
@@ -191,7 +218,7 @@ func (suite *GameTestSuite) Test_Game() {
 
 		cumulativePayouts := game.CumulativePayouts(ng.PastPhases)
 
-		for _, auction := range phase2.Auctions[:phase2ArtCt-1] {
+		for _, auction := range phase2.Auctions[:phase2.Len()-1] {
 			// should panic if auction.winningBid is nil because that should only be true
 			// for the last auction
 			auctioneer := auction.Auctioneer.Name()
@@ -202,14 +229,16 @@ func (suite *GameTestSuite) Test_Game() {
 			}
 			// subtract what buyer paid
 			expectedMoney[buyer] -= auction.WinningBid.Value
-			// add what payout gave
-			switch auction.ArtPiece.Artist {
-			case p2First:
-				expectedMoney[buyer] += cumulativePayouts[p2First]
-			case p2Second:
-				expectedMoney[buyer] += cumulativePayouts[p2Second]
-			case p2Third:
-				expectedMoney[buyer] += cumulativePayouts[p2Third]
+			// add what payout gave for each art piece
+			for _, artPiece := range auction.ArtPieces() {
+				switch artPiece.Artist {
+				case p2First:
+					expectedMoney[buyer] += cumulativePayouts[p2First]
+				case p2Second:
+					expectedMoney[buyer] += cumulativePayouts[p2Second]
+				case p2Third:
+					expectedMoney[buyer] += cumulativePayouts[p2Third]
+				}
 			}
 		}
 
@@ -225,33 +254,34 @@ func (suite *GameTestSuite) Test_Game() {
 
 	p3StartingMoney := ng.CalculateScores()
 	// do phase 3
-	isGameOver = ng.DoPhase()
+	isGameOver, err = ng.DoPhase()
+	suite.NoError(err)
 	suite.False(isGameOver)
 
 	phase3 := ng.PastPhases[2]
 	// check phase 3
 	{
 		suite.Equal(3, len(ng.PastPhases))
-		phase1ArtCt := phase1.Len()
-		phase2ArtCt := phase2.Len()
-		phase3ArtCt := phase3.Len()
+		phase1ArtCt := phase1.ArtPieceCount()
+		phase2ArtCt := phase2.ArtPieceCount()
+		phase3ArtCt := phase3.ArtPieceCount()
 		// TODO: test more granually. Check each player's card count makes sense
 		sumOfArtInHands := 0
 		for _, player := range ng.Players {
 			sumOfArtInHands += len(player.Hand)
 		}
 		// artPieces dealt = artPieces played + artPieces in hands
-		phase1Dealt := game.ArtPiecesPerPhase[playerCt][game.Phase1]
-		phase2Dealt := game.ArtPiecesPerPhase[playerCt][game.Phase2]
-		phase3Dealt := game.ArtPiecesPerPhase[playerCt][game.Phase3]
+		phase1Dealt := officialRules.ArtPiecesToDeal(playerCt, game.Phase1)
+		phase2Dealt := officialRules.ArtPiecesToDeal(playerCt, game.Phase2)
+		phase3Dealt := officialRules.ArtPiecesToDeal(playerCt, game.Phase3)
 		suite.Equal(playerCt*(phase1Dealt+phase2Dealt+phase3Dealt), phase1ArtCt+phase2ArtCt+phase3ArtCt+sumOfArtInHands,
-			"artPieces dealt (%d) = played (%d) + in hands (%d)", playerCt*game.ArtPiecesPerPhase[playerCt][game.Phase1],
+			"artPieces dealt (%d) = played (%d) + in hands (%d)", playerCt*officialRules.ArtPiecesToDeal(playerCt, game.Phase1),
 			phase1ArtCt+phase2ArtCt+phase3ArtCt, sumOfArtInHands)
 
 		// check there is one winner for the round
 		winnerCt := 0
 		for _, ct := range phase3.ArtistCounts {
-			if ct >= game.MaxArtPiecePointsPerPhase {
+			if ct >= officialRules.EndPhasePoints() {
 				winnerCt += 1
 			}
 		}
@@ -264,7 +294,7 @@ func (suite *GameTestSuite) Test_Game() {
 
 		cumulativePayouts := game.CumulativePayouts(ng.PastPhases)
 
-		for _, auction := range phase3.Auctions[:phase3ArtCt-1] {
+		for _, auction := range phase3.Auctions[:phase3.Len()-1] {
 			// should panic if auction.winningBid is nil because that should only be true
 			// for the last auction
 			auctioneer := auction.Auctioneer.Name()
@@ -275,14 +305,16 @@ func (suite *GameTestSuite) Test_Game() {
 			}
 			// subtract what buyer paid
 			expectedMoney[buyer] -= auction.WinningBid.Value
-			// add what payout gave
-			switch auction.ArtPiece.Artist {
-			case p3First:
-				expectedMoney[buyer] += cumulativePayouts[p3First]
-			case p3Second:
-				expectedMoney[buyer] += cumulativePayouts[p3Second]
-			case p3Third:
-				expectedMoney[buyer] += cumulativePayouts[p3Third]
+			// add what payout gave for each art piece
+			for _, artPiece := range auction.ArtPieces() {
+				switch artPiece.Artist {
+				case p3First:
+					expectedMoney[buyer] += cumulativePayouts[p3First]
+				case p3Second:
+					expectedMoney[buyer] += cumulativePayouts[p3Second]
+				case p3Third:
+					expectedMoney[buyer] += cumulativePayouts[p3Third]
+				}
 			}
 		}
 
@@ -298,7 +330,8 @@ func (suite *GameTestSuite) Test_Game() {
 
 	p4StartingMoney := ng.CalculateScores()
 	// do phase 4
-	isGameOver = ng.DoPhase()
+	isGameOver, err = ng.DoPhase()
+	suite.NoError(err)
 	suite.True(isGameOver)
 
 	phase4 := ng.PastPhases[3]
@@ -306,28 +339,28 @@ func (suite *GameTestSuite) Test_Game() {
 	// check phase 4
 	{
 		suite.Equal(4, len(ng.PastPhases))
-		phase1ArtCt := phase1.Len()
-		phase2ArtCt := phase2.Len()
-		phase3ArtCt := phase3.Len()
-		phase4ArtCt := phase4.Len()
+		phase1ArtCt := phase1.ArtPieceCount()
+		phase2ArtCt := phase2.ArtPieceCount()
+		phase3ArtCt := phase3.ArtPieceCount()
+		phase4ArtCt := phase4.ArtPieceCount()
 		// TODO: test more granually. Check each player's card count makes sense
 		sumOfArtInHands := 0
 		for _, player := range ng.Players {
 			sumOfArtInHands += len(player.Hand)
 		}
 		// artPieces dealt = artPieces played + artPieces in hands
-		phase1Dealt := game.ArtPiecesPerPhase[playerCt][game.Phase1]
-		phase2Dealt := game.ArtPiecesPerPhase[playerCt][game.Phase2]
-		phase3Dealt := game.ArtPiecesPerPhase[playerCt][game.Phase3]
-		phase4Dealt := game.ArtPiecesPerPhase[playerCt][game.Phase4]
+		phase1Dealt := officialRules.ArtPiecesToDeal(playerCt, game.Phase1)
+		phase2Dealt := officialRules.ArtPiecesToDeal(playerCt, game.Phase2)
+		phase3Dealt := officialRules.ArtPiecesToDeal(playerCt, game.Phase3)
+		phase4Dealt := officialRules.ArtPiecesToDeal(playerCt, game.Phase4)
 		suite.Equal(playerCt*(phase1Dealt+phase2Dealt+phase3Dealt+phase4Dealt), phase1ArtCt+phase2ArtCt+phase3ArtCt+phase4ArtCt+sumOfArtInHands,
-			"artPieces dealt (%d) = played (%d) + in hands (%d)", playerCt*game.ArtPiecesPerPhase[playerCt][game.Phase1],
+			"artPieces dealt (%d) = played (%d) + in hands (%d)", playerCt*officialRules.ArtPiecesToDeal(playerCt, game.Phase1),
 			phase1ArtCt+phase2ArtCt+phase3ArtCt+phase4ArtCt, sumOfArtInHands)
 
 		// check there is one winner for the round
 		winnerCt := 0
 		for _, ct := range phase4.ArtistCounts {
-			if ct >= game.MaxArtPiecePointsPerPhase {
+			if ct >= officialRules.EndPhasePoints() {
 				winnerCt += 1
 			}
 		}
@@ -340,7 +373,7 @@ func (suite *GameTestSuite) Test_Game() {
 
 		cumulativePayouts := game.CumulativePayouts(ng.PastPhases)
 
-		for _, auction := range phase4.Auctions[:phase4ArtCt-1] {
+		for _, auction := range phase4.Auctions[:phase4.Len()-1] {
 			// should panic if auction.winningBid is nil because that should only be true
 			// for the last auction
 			auctioneer := auction.Auctioneer.Name()
@@ -351,14 +384,16 @@ func (suite *GameTestSuite) Test_Game() {
 			}
 			// subtract what buyer paid
 			expectedMoney[buyer] -= auction.WinningBid.Value
-			// add what payout gave
-			switch auction.ArtPiece.Artist {
-			case p4First:
-				expectedMoney[buyer] += cumulativePayouts[p4First]
-			case p4Second:
-				expectedMoney[buyer] += cumulativePayouts[p4Second]
-			case p4Third:
-				expectedMoney[buyer] += cumulativePayouts[p4Third]
+			// add what payout gave for each art piece
+			for _, artPiece := range auction.ArtPieces() {
+				switch artPiece.Artist {
+				case p4First:
+					expectedMoney[buyer] += cumulativePayouts[p4First]
+				case p4Second:
+					expectedMoney[buyer] += cumulativePayouts[p4Second]
+				case p4Third:
+					expectedMoney[buyer] += cumulativePayouts[p4Third]
+				}
 			}
 		}
 
@@ -373,8 +408,277 @@ func (suite *GameTestSuite) Test_Game() {
 	}
 }
 
+func (suite *GameTestSuite) Test_DoubleAuction() {
+	// 1. Test that a double no one adds to goes to the auctioneer for free
+	{
+		playerCt := 4
+		dummies := suite.getNDummyPlayers(playerCt)
+		ng := mustNewGame(&suite.Suite, dummies)
+		ng.ArtPieces = suite.getDoubleDeck(game.Manuel, 40)
+
+		isGameOver, err := ng.DoPhase()
+		suite.NoError(err)
+		suite.False(isGameOver)
+
+		phase1 := ng.PastPhases[0]
+		suite.Equal(5, phase1.ArtPieceCount())
+		for i, auction := range phase1.Auctions[:phase1.Len()-1] {
+			suite.Nil(auction.SecondArtPiece)
+			suite.Equal(dummies[i].Name(), auction.Auctioneer.Name())
+			suite.Equal(dummies[i].Name(), auction.WinningBid.Bidder.Name())
+			suite.Equal(0, auction.WinningBid.Value)
+		}
+		// the first four auctioneers each got a Manuel for free
+		scores := ng.CalculateScores()
+		for _, dummy := range dummies {
+			suite.Equal(officialRules.StartingMoney+officialRules.RankPayouts[0], scores[dummy.Name()])
+		}
+	}
+
+	// 2. Test that a second ArtPiece offered by another player makes them the auctioneer
+	{
+		playerCt := 4
+		dummies := suite.getNDummyPlayers(playerCt)
+		ng := mustNewGame(&suite.Suite, dummies)
+		ng.ArtPieces = suite.getDoubleDeck(game.Sigrid, 40)
+
+		double := game.NewArtPieceWithAuctionType(game.Manuel, "manuel-double", game.AuctionTypeDouble)
+		second := game.NewArtPieceWithAuctionType(game.Manuel, "manuel-second", game.AuctionTypeOneShot)
+		giveArtPieces(ng.LookupGamePlayer(dummies[0].Name()), double)
+		giveArtPieces(ng.LookupGamePlayer(dummies[1].Name()), second)
+
+		isGameOver, err := ng.DoPhase()
+		suite.NoError(err)
+		suite.False(isGameOver)
+
+		phase1 := ng.PastPhases[0]
+		auction := phase1.Auctions[0]
+		suite.Equal(double, auction.ArtPiece)
+		suite.Equal(second, auction.SecondArtPiece)
+		suite.Equal(game.AuctionTypeOneShot, auction.Type)
+		suite.Equal(dummies[1].Name(), auction.Auctioneer.Name())
+		suite.NotNil(auction.WinningBid)
+		suite.Equal(game.Point(2), phase1.ArtistCounts[game.Manuel])
+		// play continues after the player who added the second ArtPiece
+		suite.Equal(dummies[2].Name(), phase1.Auctions[1].Auctioneer.Name())
+	}
+
+	// 3. Test that the auctioneer can attach their own second ArtPiece
+	{
+		playerCt := 4
+		dummies := suite.getNDummyPlayers(playerCt)
+		ng := mustNewGame(&suite.Suite, dummies)
+		ng.ArtPieces = suite.getDoubleDeck(game.Sigrid, 40)
+
+		double := game.NewArtPieceWithAuctionType(game.Manuel, "manuel-double", game.AuctionTypeDouble)
+		second := game.NewArtPieceWithAuctionType(game.Manuel, "manuel-second", game.AuctionTypeBlind)
+		giveArtPieces(ng.LookupGamePlayer(dummies[0].Name()), double, second)
+
+		isGameOver, err := ng.DoPhase()
+		suite.NoError(err)
+		suite.False(isGameOver)
+
+		auction := ng.PastPhases[0].Auctions[0]
+		suite.Equal(second, auction.SecondArtPiece)
+		suite.Equal(game.AuctionTypeBlind, auction.Type)
+		suite.Equal(dummies[0].Name(), auction.Auctioneer.Name())
+		suite.Equal(dummies[1].Name(), ng.PastPhases[0].Auctions[1].Auctioneer.Name())
+	}
+
+	// 4. Test that a second ArtPiece attached to a double that ends the phase on its own is given back
+	{
+		playerCt := 4
+		dummies := suite.getNDummyPlayers(playerCt)
+		ng := mustNewGame(&suite.Suite, dummies)
+		ng.ArtPieces = suite.getDoubleDeck(game.Sigrid, 40)
+
+		for i, dummy := range dummies {
+			giveArtPieces(ng.LookupGamePlayer(dummy.Name()), game.NewArtPieceWithAuctionType(game.Manuel, fmt.Sprintf("manuel-%d", i), game.AuctionTypeOneShot))
+		}
+		double := game.NewArtPieceWithAuctionType(game.Manuel, "manuel-double", game.AuctionTypeDouble)
+		second := game.NewArtPieceWithAuctionType(game.Manuel, "manuel-second", game.AuctionTypeOneShot)
+		giveArtPieces(ng.LookupGamePlayer(dummies[0].Name()), double, second)
+
+		isGameOver, err := ng.DoPhase()
+		suite.NoError(err)
+		suite.False(isGameOver)
+
+		phase1 := ng.PastPhases[0]
+		suite.Equal(double, phase1.Auctions[phase1.Len()-1].ArtPiece)
+		suite.Nil(phase1.Auctions[phase1.Len()-1].SecondArtPiece)
+		// the second ArtPiece is still in the hand
+		suite.Contains(handNames(&game.GamePlayer{Hand: ng.View(dummies[0].Name()).Hand()}), second.Name)
+	}
+}
+
+func (suite *GameTestSuite) Test_ManySeeds() {
+	// 1. Test that whole Games with dummies in the lineup play out over many seeds
+	{
+		for seed := int64(1); seed <= 1000; seed++ {
+			ps := []game.Player{players.NewAlphaPlayer("alpha-0"), players.NewDummyPlayer("dummy-1"), players.NewDummyPlayer("dummy-2")}
+			ng := mustNewGame(&suite.Suite, ps, game.WithSeed(seed))
+			_, err := ng.Start()
+			suite.Require().NoError(err, "seed %d", seed)
+		}
+	}
+}
+
+func (suite *GameTestSuite) Test_Seed() {
+	// 1. Test that the same seed deals the same cards
+	{
+		playerCt := 4
+		ng1 := mustNewGame(&suite.Suite, suite.getNDummyPlayers(playerCt), game.WithSeed(42))
+		ng2 := mustNewGame(&suite.Suite, suite.getNDummyPlayers(playerCt), game.WithSeed(42))
+		suite.Equal(int64(42), ng1.Seed())
+		ng1.DealArtPieces()
+		ng2.DealArtPieces()
+		for i := range ng1.Players {
+			suite.Equal(len(ng1.Players[i].Hand), len(ng2.Players[i].Hand))
+			for j := range ng1.Players[i].Hand {
+				mustMatchArtPiece(&suite.Suite, ng1.Players[i].Hand[j], ng2.Players[i].Hand[j])
+			}
+		}
+	}
+
+	// 2. Test that a different seed deals different cards
+	{
+		playerCt := 4
+		ng1 := mustNewGame(&suite.Suite, suite.getNDummyPlayers(playerCt), game.WithSeed(1))
+		ng2 := mustNewGame(&suite.Suite, suite.getNDummyPlayers(playerCt), game.WithSeed(2))
+		ng1.DealArtPieces()
+		ng2.DealArtPieces()
+		suite.NotEqual(handNames(ng1.Players[0]), handNames(ng2.Players[0]))
+	}
+
+	// 3. Test that the same seed and players play the same game, including its open auctions
+	{
+		playerCt := 4
+		ng1 := mustNewGame(&suite.Suite, suite.getNDummyPlayers(playerCt), game.WithSeed(7))
+		ng2 := mustNewGame(&suite.Suite, suite.getNDummyPlayers(playerCt), game.WithSeed(7))
+
+		scores1, err := ng1.Start()
+		suite.NoError(err)
+		scores2, err := ng2.Start()
+		suite.NoError(err)
+		suite.Equal(scores1, scores2)
+		for i, phase := range ng1.PastPhases {
+			suite.Equal(phase.ArtistCounts, ng2.PastPhases[i].ArtistCounts)
+			suite.Equal(phase.Len(), ng2.PastPhases[i].Len())
+			for j, auction := range phase.Auctions {
+				mustMatchPlayers(&suite.Suite, auction.Auctioneer, ng2.PastPhases[i].Auctions[j].Auctioneer)
+				mustMatchArtPiece(&suite.Suite, auction.ArtPiece, ng2.PastPhases[i].Auctions[j].ArtPiece)
+				if auction.WinningBid != nil {
+					mustMatchBids(&suite.Suite, auction.WinningBid, ng2.PastPhases[i].Auctions[j].WinningBid)
+				}
+			}
+		}
+	}
+}
+
+func (suite *GameTestSuite) Test_EmptyHands() {
+	// 1. Test that a player with an empty hand is skipped
+	{
+		ng := mustNewGame(&suite.Suite, suite.getNDummyPlayers(3), game.WithSeed(7))
+		_, err := ng.DoTurn()
+		suite.Require().NoError(err)
+		next := ng.Players[0]
+		suite.emptyHand(next)
+
+		phaseOver, err := ng.DoTurn()
+		suite.Require().NoError(err)
+		suite.False(phaseOver)
+		events := ng.Events()
+		skipped := events[len(events)-1]
+		suite.Require().Equal(game.EventTurnSkipped, skipped.Type)
+		suite.Equal(game.TurnSkippedEvent{Player: next.Player.Name(), EmptyHand: true}, *skipped.TurnSkipped)
+	}
+
+	// 2. Test that the Phase ends when every hand is empty, and only the artists played are paid
+	{
+		ng := mustNewGame(&suite.Suite, suite.getNDummyPlayers(3), game.WithSeed(7))
+		_, err := ng.DoTurn()
+		suite.Require().NoError(err)
+		for _, player := range ng.Players {
+			suite.emptyHand(player)
+		}
+
+		phaseOver, err := ng.DoTurn()
+		suite.Require().NoError(err)
+		suite.True(phaseOver)
+		suite.Equal(game.Phase2, ng.CurrentPhase)
+		phase := ng.PastPhases[0]
+		suite.Require().Equal(1, phase.Len())
+		suite.False(phase.IsOver())
+		artist := phase.Auctions[0].ArtPiece.Artist
+		first, second, third := phase.Winners()
+		suite.Equal([]game.Artist{artist, game.ArtistNone, game.ArtistNone}, []game.Artist{first, second, third})
+		payouts := phase.PhasePayouts()
+		for _, a := range game.AllArtists() {
+			if a == artist {
+				suite.Equal(officialRules.RankPayouts[0], payouts[a])
+			} else {
+				suite.Equal(0, payouts[a])
+			}
+		}
+	}
+
+	// 3. Test that a Phase dealt no ArtPieces ends without a turn and pays nothing
+	{
+		rules := officialRules.Copy()
+		rules.ArtPiecesPerPhase[3] = []int{0, 0, 0, 0}
+		ng := mustNewGame(&suite.Suite, suite.getNDummyPlayers(3), game.WithSeed(7), game.WithRuleSet(rules))
+		phaseOver, err := ng.DoTurn()
+		suite.Require().NoError(err)
+		suite.True(phaseOver)
+		suite.Equal(0, ng.PastPhases[0].Len())
+
+		result, err := ng.Start()
+		suite.Require().NoError(err)
+		for _, standing := range result.Standings {
+			suite.Equal(rules.StartingMoney, standing.Money)
+		}
+		for _, event := range ng.Events() {
+			suite.NotEqual(game.EventTurnSkipped, event.Type)
+		}
+	}
+
+	// 4. Test that a Game whose last Phase deals no ArtPieces plays to the end and replays
+	{
+		for seed := int64(1); seed <= 20; seed++ {
+			ng := mustNewGame(&suite.Suite, suite.getNDummyPlayers(5), game.WithSeed(seed))
+			_, err := ng.Start()
+			suite.Require().NoError(err)
+			_, err = game.Replay(ng.Events())
+			suite.Require().NoError(err)
+		}
+	}
+}
+
 // helpers
 
+// emptyHand takes all ArtPieces from the hand of the GamePlayer, who must be a DummyPlayer
+func (suite *GameTestSuite) emptyHand(gp *game.GamePlayer) {
+	gp.Hand = []*game.ArtPiece{}
+}
+
+func handNames(gp *game.GamePlayer) []string {
+	names := make([]string, len(gp.Hand))
+	for i, artPiece := range gp.Hand {
+		names[i] = artPiece.Name
+	}
+	return names
+}
+
+// giveArtPieces puts the ArtPieces in the hand of the GamePlayer and notifies the Player
+func giveArtPieces(gp *game.GamePlayer, artPieces ...*game.ArtPiece) {
+	gp.Hand = append(gp.Hand, artPieces...)
+	gp.Player.AddArtPieces(artPieces)
+}
+
+func (suite *GameTestSuite) getDoubleDeck(artist game.Artist, n int) []*game.ArtPiece {
+	return newDeck(artist, game.AuctionTypeDouble, n)
+}
+
 func (suite *GameTestSuite) getNDummyPlayers(n int) []game.Player {
 	ps := make([]game.Player, n, n)
 	for i := 0; i < n; i++ {
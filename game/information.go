@@ -0,0 +1,131 @@
+package game
+
+// publicPlayer stands in for another Player in the Auctions a Player is shown. Players only learn one
+// another's names this way and cannot reach into one another's state. It makes no decisions.
+type publicPlayer struct {
+	name string
+}
+
+var _ Player = &publicPlayer{}
+
+// Name returns the Player's name
+func (p *publicPlayer) Name() string {
+	return p.name
+}
+
+// HoldAuction passes
+func (p *publicPlayer) HoldAuction(*GameView) (*Auction, error) {
+	return nil, nil
+}
+
+// OfferDouble declines
+func (p *publicPlayer) OfferDouble(*GameView, *Auction) (*ArtPiece, error) {
+	return nil, nil
+}
+
+// SetPrice sets a price of 0
+func (p *publicPlayer) SetPrice(*GameView, *Auction) (int, error) {
+	return 0, nil
+}
+
+// Bid passes
+func (p *publicPlayer) Bid(*GameView, *Auction) (*Bid, error) {
+	return nil, nil
+}
+
+// OpenBid withdraws
+func (p *publicPlayer) OpenBid(*GameView, *Auction, Going) (*OpenBid, error) {
+	return NewWithdrawal(), nil
+}
+
+func (p *publicPlayer) HandleAuctionResult(*Auction) {}
+func (p *publicPlayer) AddArtPieces([]*ArtPiece)     {}
+func (p *publicPlayer) MoveMoney(int)                {}
+
+// publicBid returns a copy of the Bid with its Bidder replaced by a publicPlayer
+func publicBid(bid *Bid) *Bid {
+	if bid == nil {
+		return nil
+	}
+	var bidder Player
+	if bid.Bidder != nil {
+		bidder = &publicPlayer{name: bid.Bidder.Name()}
+	}
+	return NewBid(bidder, bid.Value)
+}
+
+// publicAuction returns a copy of the Auction with only the information the rules make public.
+// Its Players are replaced by publicPlayers and its BlindBids are left out unless the rules reveal them.
+func (g *Game) publicAuction(auction *Auction) *Auction {
+	newAuction := auction.copy()
+	if auction.Auctioneer != nil {
+		newAuction.Auctioneer = &publicPlayer{name: auction.Auctioneer.Name()}
+	}
+	newAuction.WinningBid = publicBid(auction.WinningBid)
+	newAuction.BlindBids = nil
+	if g.rules.RevealBlindBids {
+		for _, bid := range auction.BlindBids {
+			newAuction.BlindBids = append(newAuction.BlindBids, publicBid(bid))
+		}
+	}
+	return newAuction
+}
+
+// publicPhase returns a copy of the Phase with public copies of its Auctions
+func (g *Game) publicPhase(phase *Phase) *Phase {
+	newPhase := phase.Copy()
+	for i, auction := range newPhase.Auctions {
+		newPhase.Auctions[i] = g.publicAuction(auction)
+	}
+	return newPhase
+}
+
+// PublicEvents returns an EventListener that passes listener only the Events, and the parts of them, that every
+// player sees under the rules. Deals and the Game's seed are left out, as is money that changes hands unless the
+// rules make money open. The bids of a blind Auction are held back until its result, and left out unless the rules
+// reveal them.
+func PublicEvents(rules *RuleSet, listener EventListener) EventListener {
+	var blindBids []*Event
+	blind := false
+	return func(event *Event) {
+		switch event.Type {
+		case EventGameStart:
+			gameStart := *event.GameStart
+			gameStart.Seed = 0
+			publicEvent := *event
+			publicEvent.GameStart = &gameStart
+			event = &publicEvent
+		case EventDeal:
+			return
+		case EventAuctionStart:
+			// a double is run with the AuctionType of its second ArtPiece
+			auction := &Auction{ArtPiece: event.AuctionStart.ArtPiece, SecondArtPiece: event.AuctionStart.SecondArtPiece}
+			blind = auction.ArtPieceAuctionType() == AuctionTypeBlind
+		case EventBid, EventPass:
+			if blind {
+				blindBids = append(blindBids, event)
+				return
+			}
+		case EventAuctionResult:
+			if rules.RevealBlindBids {
+				for _, bid := range blindBids {
+					listener(bid)
+				}
+			}
+			blindBids, blind = nil, false
+		case EventTransfer:
+			if !rules.OpenMoney {
+				return
+			}
+		case EventPhaseEnd:
+			if !rules.OpenMoney {
+				phaseEnd := *event.PhaseEnd
+				phaseEnd.Money = nil
+				publicEvent := *event
+				publicEvent.PhaseEnd = &phaseEnd
+				event = &publicEvent
+			}
+		}
+		listener(event)
+	}
+}
@@ -0,0 +1,62 @@
+package game
+
+import "fmt"
+
+// MaxDecisionRetries is the number of times a misbehaving Player is asked
+// again under MisbehaviorRetry before the Game gives up on them.
+const MaxDecisionRetries = 3
+
+// MisbehaviorPolicy decides what the Game does when a Player returns an
+// error or an invalid decision.
+type MisbehaviorPolicy int
+
+// MisbehaviorPolicies
+const (
+	// MisbehaviorAbort stops the Game and returns the error. This is the default.
+	MisbehaviorAbort MisbehaviorPolicy = iota
+	// MisbehaviorRetry asks the Player again up to MaxDecisionRetries times, then stops the Game.
+	MisbehaviorRetry
+	// MisbehaviorPass treats the decision as a pass. A Player who fails to hold an
+	// Auction loses their turn and a Player who fails to bid does not bid.
+	MisbehaviorPass
+	// MisbehaviorForfeit removes the Player from play. They keep their money and
+	// collection but no longer hold Auctions or bid.
+	MisbehaviorForfeit
+)
+
+// String returns the name of the MisbehaviorPolicy
+func (mp MisbehaviorPolicy) String() string {
+	switch mp {
+	case MisbehaviorAbort:
+		return "abort"
+	case MisbehaviorRetry:
+		return "retry"
+	case MisbehaviorPass:
+		return "pass"
+	case MisbehaviorForfeit:
+		return "forfeit"
+	default:
+		return fmt.Sprintf("MisbehaviorPolicy(%d)", int(mp))
+	}
+}
+
+// handle applies the policy to a Player's misbehavior on the given attempt,
+// starting at 0. It returns true if the Player should be asked again. Otherwise,
+// it returns the error to stop the Game with, or nil if the decision counts as a pass.
+func (mp MisbehaviorPolicy) handle(player *GamePlayer, attempt int, err error) (bool, error) {
+	err = &PlayerError{Player: player.Player.Name(), Err: err}
+	switch mp {
+	case MisbehaviorRetry:
+		if attempt < MaxDecisionRetries {
+			return true, nil
+		}
+		return false, err
+	case MisbehaviorPass:
+		return false, nil
+	case MisbehaviorForfeit:
+		player.Forfeited = true
+		return false, nil
+	default:
+		return false, err
+	}
+}
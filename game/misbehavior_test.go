@@ -0,0 +1,171 @@
+package game_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/SachinMeier/modern-art.git/game"
+	"github.com/SachinMeier/modern-art.git/game/players"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+func TestMisbehaviorSuite(t *testing.T) {
+	suite.Run(t, new(MisbehaviorTestSuite))
+}
+
+type MisbehaviorTestSuite struct {
+	suite.Suite
+	testCtx    context.Context
+	cancelFunc context.CancelFunc
+}
+
+func (suite *MisbehaviorTestSuite) SetupSuite() {}
+
+func (suite *MisbehaviorTestSuite) SetupTest() {
+	suite.testCtx, suite.cancelFunc = context.WithCancel(context.Background())
+}
+
+func (suite *MisbehaviorTestSuite) TearDownTest() {
+	suite.cancelFunc()
+}
+
+func (suite *MisbehaviorTestSuite) TearDownSuite() {}
+
+func (suite *MisbehaviorTestSuite) Test_Abort() {
+	// 1. Test that an overbid stops the game with a PlayerError by default
+	{
+		overbidder := newOverbidder("overbidder", -1)
+		ng := suite.newOneShotGame(overbidder)
+
+		_, err := ng.DoPhase()
+		suite.ErrorIs(err, game.ErrNotEnoughMoney)
+		var playerErr *game.PlayerError
+		suite.True(errors.As(err, &playerErr))
+		suite.Equal("overbidder", playerErr.Player)
+	}
+
+	// 2. Test that an ArtPiece not in the auctioneer's hand stops the game
+	{
+		cheater := &cheater{DummyPlayer: players.NewDummyPlayer("cheater")}
+		ng := mustNewGame(&suite.Suite, []game.Player{cheater, players.NewDummyPlayer("dummy-1"), players.NewDummyPlayer("dummy-2")})
+
+		_, err := ng.DoPhase()
+		suite.ErrorIs(err, game.ErrArtPieceNotFound)
+	}
+}
+
+func (suite *MisbehaviorTestSuite) Test_Retry() {
+	// 1. Test that a player who recovers within the retries keeps playing
+	{
+		overbidder := newOverbidder("overbidder", game.MaxDecisionRetries)
+		ng := suite.newOneShotGame(overbidder, game.WithMisbehaviorPolicy(game.MisbehaviorRetry))
+
+		_, err := ng.DoPhase()
+		suite.NoError(err)
+	}
+
+	// 2. Test that a player who never recovers stops the game after the retries
+	{
+		overbidder := newOverbidder("overbidder", -1)
+		ng := suite.newOneShotGame(overbidder, game.WithMisbehaviorPolicy(game.MisbehaviorRetry))
+
+		_, err := ng.DoPhase()
+		suite.ErrorIs(err, game.ErrNotEnoughMoney)
+		suite.Equal(game.MaxDecisionRetries+1, overbidder.bids)
+	}
+}
+
+func (suite *MisbehaviorTestSuite) Test_Pass() {
+	// 1. Test that invalid bids are ignored and the player keeps playing
+	{
+		overbidder := newOverbidder("overbidder", -1)
+		ng := suite.newOneShotGame(overbidder, game.WithMisbehaviorPolicy(game.MisbehaviorPass))
+
+		_, err := ng.DoPhase()
+		suite.NoError(err)
+		suite.False(ng.LookupGamePlayer("overbidder").Forfeited)
+		for _, auction := range ng.PastPhases[0].Auctions[:ng.PastPhases[0].Len()-1] {
+			if auction.Auctioneer.Name() != "overbidder" {
+				suite.NotEqual("overbidder", auction.WinningBid.Bidder.Name())
+			}
+		}
+		suite.Greater(overbidder.bids, 1)
+	}
+
+	// 2. Test that a player who can't hold an auction loses their turn
+	{
+		cheater := &cheater{DummyPlayer: players.NewDummyPlayer("cheater")}
+		ng := mustNewGame(&suite.Suite, []game.Player{cheater, players.NewDummyPlayer("dummy-1"), players.NewDummyPlayer("dummy-2")},
+			game.WithMisbehaviorPolicy(game.MisbehaviorPass))
+		ng.ArtPieces = newDeck(game.Manuel, game.AuctionTypeOpen, 40)
+
+		_, err := ng.DoPhase()
+		suite.NoError(err)
+		for _, auction := range ng.PastPhases[0].Auctions {
+			suite.NotEqual("cheater", auction.Auctioneer.Name())
+		}
+	}
+}
+
+func (suite *MisbehaviorTestSuite) Test_Forfeit() {
+	// 1. Test that a misbehaving player is removed from play
+	{
+		overbidder := newOverbidder("overbidder", -1)
+		ng := suite.newOneShotGame(overbidder, game.WithMisbehaviorPolicy(game.MisbehaviorForfeit))
+
+		_, err := ng.DoPhase()
+		suite.NoError(err)
+		suite.True(ng.LookupGamePlayer("overbidder").Forfeited)
+		// the player was only asked to bid once
+		suite.Equal(1, overbidder.bids)
+		for _, auction := range ng.PastPhases[0].Auctions {
+			suite.NotEqual("overbidder", auction.Auctioneer.Name())
+		}
+	}
+}
+
+// helpers
+
+// newOneShotGame creates a Game with the player in the last seat and a deck of one-shot ArtPieces,
+// so the player has to bid in the first Auction
+func (suite *MisbehaviorTestSuite) newOneShotGame(player game.Player, opts ...game.GameOption) *game.Game {
+	ps := []game.Player{}
+	for i := 0; i < 3; i++ {
+		ps = append(ps, players.NewDummyPlayer(fmt.Sprintf("dummy-%d", i)))
+	}
+	ps = append(ps, player)
+	ng := mustNewGame(&suite.Suite, ps, opts...)
+	ng.ArtPieces = newDeck(game.Manuel, game.AuctionTypeOneShot, 40)
+	return ng
+}
+
+// overbidder bids more money than it has until it has bid badBids times.
+// A negative badBids means it never stops.
+type overbidder struct {
+	*players.DummyPlayer
+	badBids int
+	bids    int
+}
+
+func newOverbidder(name string, badBids int) *overbidder {
+	return &overbidder{DummyPlayer: players.NewDummyPlayer(name), badBids: badBids}
+}
+
+func (p *overbidder) Bid(view *game.GameView, auction *game.Auction) (*game.Bid, error) {
+	p.bids++
+	if p.badBids < 0 || p.bids <= p.badBids {
+		return game.NewBid(p, view.Money()+1), nil
+	}
+	return game.NewBid(p, 0), nil
+}
+
+// cheater auctions an ArtPiece it was never dealt
+type cheater struct {
+	*players.DummyPlayer
+}
+
+func (p *cheater) HoldAuction(view *game.GameView) (*game.Auction, error) {
+	artPiece := game.NewArtPieceWithAuctionType(game.Manuel, "forged", game.AuctionTypeOpen)
+	return game.NewAuction(p, artPiece, nil), nil
+}
@@ -0,0 +1,190 @@
+package game
+
+// Going is the auctioneer's call in an open Auction after a round of bidding without a raise
+type Going int
+
+// Goings
+const (
+	// GoingNone means the standing bid was raised in the last round, or bidding just started
+	GoingNone Going = iota
+	// GoingOnce means the last round passed without a raise
+	GoingOnce
+	// GoingTwice means the last two rounds passed without a raise. If the next one does too,
+	// the ArtPieces are sold to the standing bid.
+	GoingTwice
+)
+
+// String returns the auctioneer's call
+func (g Going) String() string {
+	switch g {
+	case GoingOnce:
+		return "going once"
+	case GoingTwice:
+		return "going twice"
+	default:
+		return "bidding"
+	}
+}
+
+// OpenBidType is the type of an OpenBid
+type OpenBidType string
+
+// OpenBidTypes
+const (
+	// OpenBidRaise raises the standing bid. The Bid must beat it.
+	OpenBidRaise OpenBidType = "raise"
+	// OpenBidPass leaves the standing bid as it is. The Player stays in the Auction.
+	OpenBidPass OpenBidType = "pass"
+	// OpenBidWithdraw leaves the Auction. The Player is not asked again.
+	OpenBidWithdraw OpenBidType = "withdraw"
+	// OpenBidClose ends the Auction and sells the ArtPieces to the standing bid. Only the auctioneer can close.
+	OpenBidClose OpenBidType = "close"
+)
+
+// OpenBid is a Player's move when asked in an open Auction
+type OpenBid struct {
+	Type OpenBidType
+	// Bid is the new standing bid of an OpenBidRaise. It is nil for other types.
+	Bid *Bid
+}
+
+// NewRaise creates an OpenBid that raises the standing bid to the Bid
+func NewRaise(bid *Bid) *OpenBid {
+	return &OpenBid{Type: OpenBidRaise, Bid: bid}
+}
+
+// NewPass creates an OpenBid that leaves the standing bid as it is
+func NewPass() *OpenBid {
+	return &OpenBid{Type: OpenBidPass}
+}
+
+// NewWithdrawal creates an OpenBid that leaves the Auction
+func NewWithdrawal() *OpenBid {
+	return &OpenBid{Type: OpenBidWithdraw}
+}
+
+// NewClose creates an OpenBid that ends the Auction
+func NewClose() *OpenBid {
+	return &OpenBid{Type: OpenBidClose}
+}
+
+// runOpenAuction runs an auction where players raise the standing bid in seat order as many times as they like.
+// In each round, every player still in the Auction other than the standing bidder is asked to raise, pass or
+// withdraw. The auctioneer is asked even while holding the standing bid, so they can close the Auction, but
+// cannot raise their own bid. After a round without a raise, the auctioneer calls "going once", then "going twice", and after a
+// third such round the ArtPieces are sold to the standing bid. The Auction also ends when everyone but the
+// standing bidder has withdrawn, or when the auctioneer closes it.
+func (g *Game) runOpenAuction(auction *Auction, bidders []*GamePlayer) error {
+	withdrawn := make(map[*GamePlayer]bool)
+	going := GoingNone
+	for {
+		raised := false
+		for _, bidder := range bidders {
+			standing := bidder.Player.Name() == auction.WinningBid.Bidder.Name()
+			if withdrawn[bidder] || (standing && bidder.Player.Name() != auction.Auctioneer.Name()) {
+				continue
+			}
+			openBid, err := g.requestOpenBid(auction, bidder, going)
+			if err != nil {
+				return err
+			}
+			// a nil OpenBid means the bidder misbehaved and is out of the Auction
+			if openBid == nil {
+				withdrawn[bidder] = true
+				continue
+			}
+			switch openBid.Type {
+			case OpenBidRaise:
+				auction.WinningBid = openBid.Bid
+				g.recordBid(EventBid, bidder, openBid.Bid.Value, true)
+				raised = true
+			case OpenBidWithdraw:
+				withdrawn[bidder] = true
+				g.recordBid(EventWithdraw, bidder, 0, false)
+			case OpenBidClose:
+				g.recordBid(EventClose, bidder, auction.WinningBid.Value, false)
+				return nil
+			}
+		}
+		if !g.openAuctionContested(auction, bidders, withdrawn) {
+			return nil
+		}
+		if raised {
+			going = GoingNone
+			continue
+		}
+		if going == GoingTwice {
+			return nil
+		}
+		going++
+	}
+}
+
+// openAuctionContested returns true if anyone other than the standing bidder is still in the open Auction
+func (g *Game) openAuctionContested(auction *Auction, bidders []*GamePlayer, withdrawn map[*GamePlayer]bool) bool {
+	for _, bidder := range bidders {
+		if !withdrawn[bidder] && bidder.Player.Name() != auction.WinningBid.Bidder.Name() {
+			return true
+		}
+	}
+	return false
+}
+
+// requestOpenBid asks the bidder for their move in an open Auction and validates it, applying the
+// MisbehaviorPolicy if it is invalid. Returns a nil OpenBid if the bidder is out of the Auction.
+func (g *Game) requestOpenBid(auction *Auction, bidder *GamePlayer, going Going) (*OpenBid, error) {
+	for attempt := 0; ; attempt++ {
+		ctx, cancel := g.decisionContext()
+		openBid, err := bidder.decider().OpenBidContext(ctx, g.view(bidder), g.publicAuction(auction), going)
+		cancel()
+		if timedOut, err := g.checkTimeout(bidder, DecisionOpenBid, err); err != nil {
+			return nil, err
+		} else if timedOut {
+			return NewPass(), nil
+		}
+		if err == nil {
+			err = validateOpenBid(auction, bidder, openBid)
+		}
+		if err == nil {
+			return openBid, nil
+		}
+		retry, err := g.misbehaviorPolicy.handle(bidder, attempt, err)
+		if err != nil {
+			return nil, err
+		}
+		if !retry {
+			g.recordBid(EventPass, bidder, 0, false)
+			return nil, nil
+		}
+	}
+}
+
+// validateOpenBid ensures a raise is a valid Bid that beats someone else's standing bid and that only the
+// auctioneer closes
+func validateOpenBid(auction *Auction, bidder *GamePlayer, openBid *OpenBid) error {
+	if openBid == nil {
+		return ErrInvalidOpenBid
+	}
+	switch openBid.Type {
+	case OpenBidRaise:
+		if err := validateBid(bidder, openBid.Bid); err != nil {
+			return err
+		}
+		if bidder.Player.Name() == auction.WinningBid.Bidder.Name() {
+			return ErrStandingBidder
+		}
+		if openBid.Bid.Value <= auction.WinningBid.Value {
+			return ErrBidTooLow
+		}
+		return nil
+	case OpenBidPass, OpenBidWithdraw:
+		return nil
+	case OpenBidClose:
+		if bidder.Player.Name() != auction.Auctioneer.Name() {
+			return ErrNotAuctioneer
+		}
+		return nil
+	default:
+		return ErrInvalidOpenBid
+	}
+}
@@ -0,0 +1,71 @@
+package game
+
+import (
+	"context"
+	"math/rand"
+	"time"
+)
+
+// GameOption configures a Game in NewGame
+type GameOption func(*Game)
+
+// WithMisbehaviorPolicy sets what the Game does when a Player returns an error
+// or an invalid decision. The default is MisbehaviorAbort.
+func WithMisbehaviorPolicy(policy MisbehaviorPolicy) GameOption {
+	return func(g *Game) {
+		g.misbehaviorPolicy = policy
+	}
+}
+
+// WithRuleSet plays the Game with the RuleSet instead of the official rules. NewGame returns
+// ErrInvalidRuleSet if it is invalid.
+func WithRuleSet(rules *RuleSet) GameOption {
+	return func(g *Game) {
+		g.rules = rules.Copy()
+	}
+}
+
+// WithSeed seeds the Game's randomness, which decides the cards dealt and is passed on
+// to every RandPlayer. The same seed and the same Players always produce the same Game.
+func WithSeed(seed int64) GameOption {
+	return func(g *Game) {
+		g.seed = seed
+		g.source = newCountingSource(seed, 0)
+		g.rand = rand.New(g.source)
+	}
+}
+
+// WithRandSource sets the source of the Game's randomness, which decides the cards dealt
+// and is passed on to every RandPlayer. Games with a rand.Source cannot be snapshotted.
+func WithRandSource(src rand.Source) GameOption {
+	return func(g *Game) {
+		g.seed = 0
+		g.source = nil
+		g.rand = rand.New(src)
+	}
+}
+
+// WithContext cancels the Game when ctx is done. The decision in progress is cancelled
+// and the Game stops with ctx's error.
+func WithContext(ctx context.Context) GameOption {
+	return func(g *Game) {
+		g.ctx = ctx
+	}
+}
+
+// WithDecisionTimeout gives Players a deadline for each decision. A Player who misses it passes
+// on a Bid, passes in an open Auction, declines a double and sets a price of 0. An auctioneer who
+// misses it auctions a random ArtPiece from their hand. The default is no deadline.
+func WithDecisionTimeout(timeout time.Duration) GameOption {
+	return func(g *Game) {
+		g.decisionTimeout = timeout
+	}
+}
+
+// WithEventListener calls the listener with every Event as soon as the Game emits it,
+// including the Events emitted in NewGame
+func WithEventListener(listener EventListener) GameOption {
+	return func(g *Game) {
+		g.eventListeners = append(g.eventListeners, listener)
+	}
+}
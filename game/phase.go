@@ -13,11 +13,7 @@ const (
 	Phase4
 )
 
-// MaxArtPiecePointsPerPhase is the minimum number of points for a given artist
-// Use this when comparing with ArtistCounts
-var MaxArtPiecePointsPerPhase = Point(MaxArtPiecesPerPhase) // 50
-
-// AllPhases returns a slice of all PhaseNumbers
+// AllPhases returns a slice of all PhaseNumbers of the official rules
 func AllPhases() []PhaseNumber {
 	return []PhaseNumber{Phase1, Phase2, Phase3, Phase4}
 }
@@ -26,10 +22,18 @@ func AllPhases() []PhaseNumber {
 type Phase struct {
 	Auctions     []*Auction
 	ArtistCounts map[Artist]int
+
+	// rules decide when the Phase ends and what it pays. A Phase without rules follows the official rules.
+	rules *RuleSet
 }
 
-// NewPhase creates a new Phase with all artists at 0 points.
+// NewPhase creates a new Phase with all artists at 0 points, following the official rules.
 func NewPhase() *Phase {
+	return DefaultRuleSet().NewPhase()
+}
+
+// NewPhase creates a new Phase with all artists at 0 points, following the RuleSet
+func (r *RuleSet) NewPhase() *Phase {
 	counts := make(map[Artist]int)
 	for _, artist := range AllArtists() {
 		counts[artist] = 0
@@ -37,12 +41,21 @@ func NewPhase() *Phase {
 	return &Phase{
 		Auctions:     []*Auction{},
 		ArtistCounts: counts,
+		rules:        r,
 	}
 }
 
+// ruleSet returns the rules of the Phase
+func (p *Phase) ruleSet() *RuleSet {
+	if p.rules == nil {
+		return DefaultRuleSet()
+	}
+	return p.rules
+}
+
 // Copy returns a copy of the Phase
 func (p *Phase) Copy() *Phase {
-	newPhase := NewPhase()
+	newPhase := p.ruleSet().NewPhase()
 	newPhase.Auctions = make([]*Auction, len(p.Auctions))
 	copy(newPhase.Auctions, p.Auctions)
 	newPhase.ArtistCounts = make(map[Artist]int)
@@ -59,28 +72,47 @@ func (p *Phase) Len() int {
 
 // IsOver returns true if the given ArtPiece ends the phase.
 func (p *Phase) IsOver() bool {
-	// >= allows playing a double when there are 4 pieces down.
-	// TODO: check rules on this
-	// TODO: edit when doubles are possible
+	// >= allows playing a double and its second ArtPiece when there are 4 pieces down.
+	// Both pieces count towards the Artist's rank.
 	for _, artist := range AllArtists() {
-		if p.ArtistCounts[artist] >= MaxArtPiecePointsPerPhase {
+		if p.ArtistCounts[artist] >= p.ruleSet().EndPhasePoints() {
 			return true
 		}
 	}
 	return false
 }
 
-// AddAuction adds PointsPerArtPiece points to the artist's score
-// and appends the Auction to the Phase.
+// EndsPhase returns true if playing one more ArtPiece by the artist would end the Phase.
+func (p *Phase) EndsPhase(artist Artist) bool {
+	return p.ArtistCounts[artist]+PointsPerArtPiece >= p.ruleSet().EndPhasePoints()
+}
+
+// AddAuction adds PointsPerArtPiece points to the artist's score for each
+// ArtPiece in the Auction and appends the Auction to the Phase.
 func (p *Phase) AddAuction(auction *Auction) {
 	p.Auctions = append(p.Auctions, auction)
-	p.ArtistCounts[auction.ArtPiece.Artist] += PointsPerArtPiece
+	for _, artPiece := range auction.ArtPieces() {
+		p.ArtistCounts[artPiece.Artist] += PointsPerArtPiece
+	}
 }
 
-// Winners returns the top 3 artists in the phase.
+// ArtPieceCount returns the number of ArtPieces played in the Phase.
+// This differs from Len when double Auctions were held.
+func (p *Phase) ArtPieceCount() int {
+	count := 0
+	for _, auction := range p.Auctions {
+		count += len(auction.ArtPieces())
+	}
+	return count
+}
+
+// Winners returns the top 3 artists in the phase. Ranks without an ArtPiece in the phase are ArtistNone.
 func (p *Phase) Winners() (Artist, Artist, Artist) {
 	artists := p.RankedArtists()
 	first, second, third := artists[0], artists[1], artists[2]
+	if p.ArtistCounts[first] < PointsPerArtPiece {
+		first = ArtistNone
+	}
 	if p.ArtistCounts[second] < PointsPerArtPiece {
 		second = ArtistNone
 	}
@@ -93,7 +125,7 @@ func (p *Phase) Winners() (Artist, Artist, Artist) {
 
 // RankedArtists returns a slice of artists sorted by points.
 func (p *Phase) RankedArtists() []Artist {
-	artistCounts := AddTieBreakers(p.ArtistCounts)
+	artistCounts := p.ruleSet().AddTieBreakers(p.ArtistCounts)
 
 	artists := AllArtists()
 
@@ -104,20 +136,16 @@ func (p *Phase) RankedArtists() []Artist {
 	return artists
 }
 
-// PhasePayouts returns a map of artists to their payouts for this isolated Phase
+// PhasePayouts returns a map of artists to their payouts for this isolated Phase.
+// The top artists are paid the RankPayouts of the rules. An artist needs an ArtPiece in the Phase to be paid,
+// so a Phase that ran out of cards before any were played pays nothing.
 func (p *Phase) PhasePayouts() map[Artist]int {
-	first, second, third := p.Winners()
+	rankPayouts := p.ruleSet().RankPayouts
 	payouts := make(map[Artist]int)
-	for _, artist := range AllArtists() {
-		switch artist {
-		case first:
-			payouts[artist] = RankPayout1
-		case second:
-			payouts[artist] = RankPayout2
-		case third:
-			payouts[artist] = RankPayout3
-		default:
-			payouts[artist] = 0
+	for i, artist := range p.RankedArtists() {
+		payouts[artist] = 0
+		if i < len(rankPayouts) && p.ArtistCounts[artist] >= PointsPerArtPiece {
+			payouts[artist] = rankPayouts[i]
 		}
 	}
 
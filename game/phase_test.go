@@ -186,9 +186,9 @@ func (suite *PhaseTestSuite) Test_PhasePayouts() {
 
 		suite.Equal(0, payouts[game.Manuel])
 		suite.Equal(0, payouts[game.Sigrid])
-		suite.Equal(game.RankPayout3, payouts[game.Daniel])
-		suite.Equal(game.RankPayout2, payouts[game.Ramon])
-		suite.Equal(game.RankPayout1, payouts[game.Rafael])
+		suite.Equal(officialRules.RankPayouts[2], payouts[game.Daniel])
+		suite.Equal(officialRules.RankPayouts[1], payouts[game.Ramon])
+		suite.Equal(officialRules.RankPayouts[0], payouts[game.Rafael])
 	}
 
 	// only 2 winners
@@ -200,8 +200,8 @@ func (suite *PhaseTestSuite) Test_PhasePayouts() {
 		suite.Equal(0, payouts[game.Manuel])
 		suite.Equal(0, payouts[game.Sigrid])
 		suite.Equal(0, payouts[game.Daniel])
-		suite.Equal(game.RankPayout2, payouts[game.Ramon])
-		suite.Equal(game.RankPayout1, payouts[game.Rafael])
+		suite.Equal(officialRules.RankPayouts[1], payouts[game.Ramon])
+		suite.Equal(officialRules.RankPayouts[0], payouts[game.Rafael])
 	}
 
 	// only 1 winner
@@ -210,7 +210,7 @@ func (suite *PhaseTestSuite) Test_PhasePayouts() {
 		payouts := phase.PhasePayouts()
 		suite.Equal(5, len(payouts))
 
-		suite.Equal(game.RankPayout1, payouts[game.Manuel])
+		suite.Equal(officialRules.RankPayouts[0], payouts[game.Manuel])
 		suite.Equal(0, payouts[game.Sigrid])
 		suite.Equal(0, payouts[game.Daniel])
 		suite.Equal(0, payouts[game.Ramon])
@@ -223,9 +223,9 @@ func (suite *PhaseTestSuite) Test_PhasePayouts() {
 		payouts := phase.PhasePayouts()
 		suite.Equal(5, len(payouts))
 
-		suite.Equal(game.RankPayout1, payouts[game.Manuel])
-		suite.Equal(game.RankPayout2, payouts[game.Sigrid])
-		suite.Equal(game.RankPayout3, payouts[game.Daniel])
+		suite.Equal(officialRules.RankPayouts[0], payouts[game.Manuel])
+		suite.Equal(officialRules.RankPayouts[1], payouts[game.Sigrid])
+		suite.Equal(officialRules.RankPayouts[2], payouts[game.Daniel])
 		suite.Equal(0, payouts[game.Ramon])
 		suite.Equal(0, payouts[game.Rafael])
 	}
@@ -241,29 +241,29 @@ func (suite *PhaseTestSuite) Test_CumulativePayouts() {
 		suite.Equal(5, len(payouts))
 		suite.Equal(0, payouts[game.Manuel])
 		suite.Equal(0, payouts[game.Sigrid])
-		suite.Equal(game.RankPayout3, payouts[game.Daniel])
-		suite.Equal(game.RankPayout2, payouts[game.Ramon])
-		suite.Equal(game.RankPayout1, payouts[game.Rafael])
+		suite.Equal(officialRules.RankPayouts[2], payouts[game.Daniel])
+		suite.Equal(officialRules.RankPayouts[1], payouts[game.Ramon])
+		suite.Equal(officialRules.RankPayouts[0], payouts[game.Rafael])
 	}
 
 	// test 2 rounds
 	{
-		phases := []game.Phase{
+		phases := []*game.Phase{
 			newPhase(1, 2, 3, 4, 5),
 			newPhase(5, 4, 3, 2, 1),
 		}
 		payouts := game.CumulativePayouts(phases)
 		suite.Equal(5, len(payouts))
-		suite.Equal(game.RankPayout1, payouts[game.Manuel])
-		suite.Equal(game.RankPayout2, payouts[game.Sigrid])
-		suite.Equal(game.RankPayout3+game.RankPayout3, payouts[game.Daniel])
+		suite.Equal(officialRules.RankPayouts[0], payouts[game.Manuel])
+		suite.Equal(officialRules.RankPayouts[1], payouts[game.Sigrid])
+		suite.Equal(officialRules.RankPayouts[2]+officialRules.RankPayouts[2], payouts[game.Daniel])
 		suite.Equal(0, payouts[game.Ramon])
 		suite.Equal(0, payouts[game.Rafael])
 	}
 
 	// test 3 rounds
 	{
-		phases := []game.Phase{
+		phases := []*game.Phase{
 			newPhase(1, 2, 3, 4, 5),
 			newPhase(5, 4, 3, 2, 1),
 			newPhase(0, 0, 5, 2, 3),
@@ -272,14 +272,14 @@ func (suite *PhaseTestSuite) Test_CumulativePayouts() {
 		suite.Equal(5, len(payouts))
 		suite.Equal(0, payouts[game.Manuel])
 		suite.Equal(0, payouts[game.Sigrid])
-		suite.Equal(game.RankPayout1+game.RankPayout3+game.RankPayout3, payouts[game.Daniel])
-		suite.Equal(game.RankPayout3+game.RankPayout2, payouts[game.Ramon])
-		suite.Equal(game.RankPayout2+game.RankPayout1, payouts[game.Rafael])
+		suite.Equal(officialRules.RankPayouts[0]+officialRules.RankPayouts[2]+officialRules.RankPayouts[2], payouts[game.Daniel])
+		suite.Equal(officialRules.RankPayouts[2]+officialRules.RankPayouts[1], payouts[game.Ramon])
+		suite.Equal(officialRules.RankPayouts[1]+officialRules.RankPayouts[0], payouts[game.Rafael])
 	}
 
 	// test 4 rounds, past ones incomplete
 	{
-		phases := []game.Phase{
+		phases := []*game.Phase{
 			newPhase(0, 0, 0, 5, 4),
 			newPhase(5, 0, 0, 0, 0),
 			newPhase(0, 0, 5, 0, 0),
@@ -289,14 +289,14 @@ func (suite *PhaseTestSuite) Test_CumulativePayouts() {
 		suite.Equal(5, len(payouts))
 		suite.Equal(0, payouts[game.Manuel])
 		suite.Equal(0, payouts[game.Sigrid])
-		suite.Equal(game.RankPayout3+game.RankPayout1, payouts[game.Daniel])
-		suite.Equal(game.RankPayout2+game.RankPayout1, payouts[game.Ramon])
-		suite.Equal(game.RankPayout1+game.RankPayout2, payouts[game.Rafael])
+		suite.Equal(officialRules.RankPayouts[2]+officialRules.RankPayouts[0], payouts[game.Daniel])
+		suite.Equal(officialRules.RankPayouts[1]+officialRules.RankPayouts[0], payouts[game.Ramon])
+		suite.Equal(officialRules.RankPayouts[0]+officialRules.RankPayouts[1], payouts[game.Rafael])
 	}
 
 	// test 4 rounds, current one incomplete
 	{
-		phases := []game.Phase{
+		phases := []*game.Phase{
 			newPhase(0, 0, 0, 5, 4),
 			newPhase(5, 0, 0, 0, 0),
 			newPhase(0, 0, 5, 0, 0),
@@ -305,10 +305,56 @@ func (suite *PhaseTestSuite) Test_CumulativePayouts() {
 		payouts := game.CumulativePayouts(phases)
 		suite.Equal(5, len(payouts))
 		suite.Equal(0, payouts[game.Manuel])
-		suite.Equal(game.RankPayout2, payouts[game.Sigrid])
-		suite.Equal(game.RankPayout1+game.RankPayout1, payouts[game.Daniel])
+		suite.Equal(officialRules.RankPayouts[1], payouts[game.Sigrid])
+		suite.Equal(officialRules.RankPayouts[0]+officialRules.RankPayouts[0], payouts[game.Daniel])
 		suite.Equal(0, payouts[game.Ramon])
 		suite.Equal(0, payouts[game.Rafael])
 	}
 
 }
+
+func (suite *PhaseTestSuite) Test_PhaseAddDoubleAuction() {
+	// 1. Test that both ArtPieces of a double Auction are counted
+	{
+		p1 := players.NewDummyPlayer("1")
+		p2 := players.NewDummyPlayer("2")
+		phase := game.NewPhase()
+
+		auction := newDoubleAuctionWithWinningBid(p1, game.Manuel, game.AuctionTypeOneShot, p2, 1)
+		phase.AddAuction(auction)
+		suite.Equal(1, phase.Len())
+		suite.Equal(2, phase.ArtPieceCount())
+		suite.Equal(game.Point(2), phase.ArtistCounts[game.Manuel])
+	}
+
+	// 2. Test that a double without a second ArtPiece counts once
+	{
+		p1 := players.NewDummyPlayer("1")
+		phase := game.NewPhase()
+
+		double := game.NewArtPieceWithAuctionType(game.Sigrid, "double", game.AuctionTypeDouble)
+		phase.AddAuction(game.NewAuction(p1, double, game.NewBid(p1, 0)))
+		suite.Equal(1, phase.ArtPieceCount())
+		suite.Equal(game.Point(1), phase.ArtistCounts[game.Sigrid])
+	}
+
+	// 3. Test that a double and its second ArtPiece can end the phase
+	{
+		p1 := players.NewDummyPlayer("1")
+		p2 := players.NewDummyPlayer("2")
+		phase := newPhase(3, 0, 0, 0, 0)
+		suite.False(phase.IsOver())
+		suite.False(phase.EndsPhase(game.Manuel))
+
+		phase.AddAuction(newDoubleAuctionWithWinningBid(p1, game.Manuel, game.AuctionTypeOpen, p2, 1))
+		suite.True(phase.IsOver())
+		suite.Equal(game.Point(5), phase.ArtistCounts[game.Manuel])
+	}
+
+	// 4. Test that EndsPhase is true when 4 pieces are down
+	{
+		phase := newPhase(4, 0, 0, 0, 0)
+		suite.True(phase.EndsPhase(game.Manuel))
+		suite.False(phase.EndsPhase(game.Sigrid))
+	}
+}
@@ -1,5 +1,7 @@
 package game
 
+import "math/rand"
+
 // GamePlayer is a Player in the Game. It is used to allow the Game
 // to keep track of the Player's Hand, Collection, and Money
 type GamePlayer struct {
@@ -7,6 +9,21 @@ type GamePlayer struct {
 	Hand       []*ArtPiece
 	Collection []*ArtPiece
 	Money      int
+	// Seat is the Player's place at the table, counted clockwise from the first Player. It never changes.
+	Seat int
+	// Forfeited is true if the Player was removed from play for misbehaving
+	Forfeited bool
+
+	contextPlayer ContextPlayer
+}
+
+// decider returns the Player as a ContextPlayer. It is kept for the whole Game, so that a
+// decision abandoned at its deadline finishes before the Player is asked again.
+func (gp *GamePlayer) decider() ContextPlayer {
+	if gp.contextPlayer == nil {
+		gp.contextPlayer = NewContextPlayer(gp.Player)
+	}
+	return gp.contextPlayer
 }
 
 // NewGamePlayer creates a new GamePlayer from a Player
@@ -32,25 +49,65 @@ func (gp *GamePlayer) RemoveArtPieceFromHand(artPiece *ArtPiece) error {
 	return err
 }
 
+// HasArtPiece returns true if the ArtPiece is in the GamePlayer's Hand
+func (gp *GamePlayer) HasArtPiece(artPiece *ArtPiece) bool {
+	for _, piece := range gp.Hand {
+		if piece == artPiece {
+			return true
+		}
+	}
+	return false
+}
+
 // TODO: maybe make GamePlayer implement Player interface? use embedding?
 
-// Player is an interface for a player in the Game
+// Player is an interface for a player in the Game. Every decision comes with
+// a GameView of the public information of the Game and the Player's own hand and money.
 type Player interface {
 	// Name returns the Player's name
 	Name() string
-	// HoldAuction requests the Player to put an ArtPiece up for Auction
-	HoldAuction() (*Auction, error)
-	// Bid requests the Player to place a Bid on an Auction
-	Bid(*Auction) (*Bid, error)
-	// OpenBid requests the Player to place a Bid on an Auction of type AuctionTypeOpen
-	// Players should listen for new winning bids on the recv channel and send their bids on the send channel.
-	// The game will close the recv channel when the auction is over.
-	OpenBid(*Auction, <-chan *Bid, chan<- *Bid)
+	// HoldAuction requests the Player to put an ArtPiece up for Auction. When auctioning a
+	// double ArtPiece, the Player may attach a second ArtPiece of the same Artist as the
+	// Auction's SecondArtPiece. Otherwise, the other Players are offered to add one.
+	HoldAuction(*GameView) (*Auction, error)
+	// OfferDouble offers the Player to add a second ArtPiece to another Player's double Auction.
+	// The Player returns the ArtPiece to add, or nil to decline. A Player who adds an ArtPiece
+	// becomes the Auctioneer and receives the money from the sale of both ArtPieces.
+	OfferDouble(*GameView, *Auction) (*ArtPiece, error)
+	// SetPrice requests the Player to set the price of their Auction of type AuctionTypeSetPrice.
+	// The price can be at most the Player's money, as they must buy the ArtPieces if no one accepts it.
+	SetPrice(*GameView, *Auction) (int, error)
+	// Bid requests the Player to place a Bid on an Auction. In an Auction of type AuctionTypeSetPrice,
+	// the price is the Auction's WinningBid, a Bid of at least the price accepts it and a nil Bid declines it.
+	Bid(*GameView, *Auction) (*Bid, error)
+	// OpenBid requests the Player's move in an Auction of type AuctionTypeOpen. The Auction's WinningBid is
+	// the standing bid and Going is the auctioneer's call. The Player is asked again every round until they
+	// withdraw or the Auction ends, and learns the result through HandleAuctionResult. The standing bidder is
+	// only asked if they are the auctioneer, who can then close the Auction but not raise their own bid.
+	OpenBid(*GameView, *Auction, Going) (*OpenBid, error)
 	// HandleAuctionResult informs the Player of the result of an Auction by sharing the wining Auction.
-	// If a player wins an auction, they should add the ArtPiece to their collection.
+	// If a player wins an auction, they should add the Auction's ArtPieces to their collection.
 	HandleAuctionResult(*Auction)
-	// AddArtPieces adds ArtPiece's to the Player's hand
+	// AddArtPieces adds ArtPiece's to the Player's hand when they are dealt, or when an ArtPiece the Player
+	// attached to a double Auction is given back because the double ended the Phase on its own
 	AddArtPieces([]*ArtPiece)
 	// MoveMoney gives the Player money. Currently only used for payouts.
 	MoveMoney(int)
 }
+
+// RandPlayer is implemented by Players that make random decisions. The Game gives
+// them a source of randomness derived from its own in NewGame, so that Games with
+// the same seed are reproducible.
+type RandPlayer interface {
+	Player
+	// SetRand sets the Player's source of randomness
+	SetRand(*rand.Rand)
+}
+
+// ResultPlayer is implemented by Players that want to know how the Game ended, such as
+// Players in another process that must be told the Game is over.
+type ResultPlayer interface {
+	Player
+	// HandleGameResult informs the Player of the GameResult once the Game is over
+	HandleGameResult(*GameResult)
+}
@@ -1,5 +1,7 @@
 package game
 
+import "sort"
+
 // PlayerOrder a FIFO queue of players
 type PlayerOrder []*GamePlayer
 
@@ -8,6 +10,7 @@ func NewPlayerOrder(players []Player) PlayerOrder {
 	gamePlayers := make([]*GamePlayer, len(players), len(players))
 	for i, player := range players {
 		gamePlayers[i] = NewGamePlayer(player)
+		gamePlayers[i].Seat = i
 	}
 	return gamePlayers
 }
@@ -31,3 +34,47 @@ func (po *PlayerOrder) Copy() PlayerOrder {
 	copy(newPo, *po)
 	return newPo
 }
+
+// BiddingOrder returns the players in seat order starting with the player to the auctioneer's left,
+// with the auctioneer last. Every AuctionType asks the players in this order.
+func (po *PlayerOrder) BiddingOrder(auctioneer *GamePlayer) PlayerOrder {
+	n := len(*po)
+	order := po.Copy()
+	sort.SliceStable(order, func(i, j int) bool {
+		return (order[i].Seat-auctioneer.Seat-1+n)%n < (order[j].Seat-auctioneer.Seat-1+n)%n
+	})
+	return order
+}
+
+// Active returns the players in the PlayerOrder who have not forfeited
+func (po *PlayerOrder) Active() PlayerOrder {
+	active := make(PlayerOrder, 0, len(*po))
+	for _, player := range *po {
+		if !player.Forfeited {
+			active = append(active, player)
+		}
+	}
+	return active
+}
+
+// HandsEmpty returns true if none of the active players has an ArtPiece left to auction
+func (po *PlayerOrder) HandsEmpty() bool {
+	for _, player := range po.Active() {
+		if len(player.Hand) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// RotateTo rotates the PlayerOrder so that the given player is last. Play
+// continues with the player seated after them. Does nothing if the player is
+// not in the PlayerOrder.
+func (po *PlayerOrder) RotateTo(player *GamePlayer) {
+	for i, p := range *po {
+		if p == player {
+			*po = append((*po)[i+1:], (*po)[:i+1]...)
+			return
+		}
+	}
+}
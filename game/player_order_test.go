@@ -90,3 +90,55 @@ func (suite *PlayerOrderTestSuite) Test_PlayerOrder_Copy() {
 		suite.Equal(4, len(order))
 	}
 }
+
+func (suite *PlayerOrderTestSuite) Test_PlayerOrder_RotateTo() {
+	// 1. Test that the given player ends up last and seat order is kept
+	{
+		dummies := []game.Player{
+			players.NewDummyPlayer("1"),
+			players.NewDummyPlayer("2"),
+			players.NewDummyPlayer("3"),
+			players.NewDummyPlayer("4"),
+		}
+
+		order := game.NewPlayerOrder(dummies)
+		order.RotateTo(order[1])
+		suite.Equal(4, len(order))
+		suite.Equal("3", order[0].Player.Name())
+		suite.Equal("4", order[1].Player.Name())
+		suite.Equal("1", order[2].Player.Name())
+		suite.Equal("2", order[3].Player.Name())
+
+		// rotating to the last player changes nothing
+		order.RotateTo(order[3])
+		suite.Equal("3", order[0].Player.Name())
+		suite.Equal("2", order[3].Player.Name())
+	}
+}
+
+func (suite *PlayerOrderTestSuite) Test_PlayerOrder_BiddingOrder() {
+	// 1. Test that bidding starts left of the auctioneer, who bids last, however the order was rotated
+	{
+		dummies := []game.Player{
+			players.NewDummyPlayer("1"),
+			players.NewDummyPlayer("2"),
+			players.NewDummyPlayer("3"),
+			players.NewDummyPlayer("4"),
+		}
+
+		order := game.NewPlayerOrder(dummies)
+		auctioneer := order[1]
+		suite.Equal(1, auctioneer.Seat)
+		for i := 0; i < len(order); i++ {
+			bidders := order.BiddingOrder(auctioneer)
+			names := make([]string, len(bidders))
+			for j, bidder := range bidders {
+				names[j] = bidder.Player.Name()
+			}
+			suite.Equal([]string{"3", "4", "1", "2"}, names)
+			order.Push(order.Pop())
+		}
+		// seats do not change as the order rotates
+		suite.Equal(1, auctioneer.Seat)
+	}
+}
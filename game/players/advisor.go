@@ -0,0 +1,352 @@
+package players
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/SachinMeier/modern-art.git/game"
+	"io"
+	"math/rand"
+	"os"
+	"strings"
+)
+
+/*
+AdvisorPlayer is a human player with an AlphaPlayer looking over their shoulder. Before each decision it shows
+what the AlphaPlayer would do, with its ExpectedValue and ExpectedBid, and the human accepts the suggestion with
+Enter or overrides it, in which case the decision is left to the wrapped Player, usually an IOPlayer. Overrides
+are recorded, so that the decisions where humans beat the bot can be studied.
+*/
+
+// AdvisorPlayer is a human Player who is advised by an AlphaPlayer
+type AdvisorPlayer struct {
+	human   game.Player
+	advisor *AlphaPlayer
+	in      io.Reader
+	out     io.Writer
+
+	overrides []*Override
+	log       *json.Encoder
+}
+
+// Ensures that AdvisorPlayer implements RandPlayer interface at compile time
+var _ game.RandPlayer = &AdvisorPlayer{}
+
+// Override is a decision in which the human chose otherwise than the advisor suggested
+type Override struct {
+	Player   string           `json:"player"`
+	Phase    game.PhaseNumber `json:"phase"`
+	Decision game.Decision    `json:"decision"`
+	// Auction is the Auction decided on. It is nil for hold-auction.
+	Auction *game.AuctionSnapshot `json:"auction,omitempty"`
+	Money   int                   `json:"money"`
+	// ExpectedValue and ExpectedBid are the advisor's, for the suggested ArtPiece of hold-auction and for the
+	// ArtPieces of the Auction otherwise
+	ExpectedValue int     `json:"expected_value"`
+	ExpectedBid   int     `json:"expected_bid"`
+	Suggested     *Choice `json:"suggested"`
+	Chosen        *Choice `json:"chosen"`
+}
+
+// Choice is what a Player decided
+type Choice struct {
+	// ArtPiece and SecondArtPiece are the ArtPieces auctioned for hold-auction. ArtPiece is the one added for
+	// offer-double, or nil to decline.
+	ArtPiece       *game.ArtPiece `json:"art_piece,omitempty"`
+	SecondArtPiece *game.ArtPiece `json:"second_art_piece,omitempty"`
+	// Value is the price for set-price, and the bid for bid and an open-bid raise
+	Value int `json:"value"`
+	// OpenBid is the type of an open-bid
+	OpenBid game.OpenBidType `json:"open_bid,omitempty"`
+	// Decline is true for a bid of nil, which declines a set price
+	Decline bool `json:"decline,omitempty"`
+}
+
+// NewAdvisorPlayer creates a new AdvisorPlayer for the human Player, who is asked on stdin and stdout
+// whether to accept each suggestion
+func NewAdvisorPlayer(human game.Player) *AdvisorPlayer {
+	return &AdvisorPlayer{
+		human:   human,
+		advisor: NewAlphaPlayer(human.Name()),
+		in:      os.Stdin,
+		out:     os.Stdout,
+	}
+}
+
+// SetInput sets where the human's answers to suggestions are read from. It is read one byte at a time,
+// so that the rest of the input is left to the human Player.
+func (p *AdvisorPlayer) SetInput(in io.Reader) {
+	p.in = in
+}
+
+// SetOutput sets where suggestions are shown
+func (p *AdvisorPlayer) SetOutput(out io.Writer) {
+	p.out = out
+}
+
+// SetOverrideLog writes each Override to w as a JSON line as it happens.
+// Write errors are ignored so that a broken log never stops a Game.
+func (p *AdvisorPlayer) SetOverrideLog(w io.Writer) {
+	p.log = json.NewEncoder(w)
+}
+
+// Overrides returns the Overrides so far, in order
+func (p *AdvisorPlayer) Overrides() []*Override {
+	return p.overrides
+}
+
+// Name returns the Player's name
+func (p *AdvisorPlayer) Name() string {
+	return p.human.Name()
+}
+
+// SetRand passes the Game's source of randomness on to the human Player, if they use one
+func (p *AdvisorPlayer) SetRand(rng *rand.Rand) {
+	if randPlayer, ok := p.human.(game.RandPlayer); ok {
+		randPlayer.SetRand(rng)
+	}
+}
+
+// HoldAuction suggests the AlphaPlayer's ArtPiece to auction
+func (p *AdvisorPlayer) HoldAuction(view *game.GameView) (*game.Auction, error) {
+	suggested, err := p.advisor.HoldAuction(view)
+	if err != nil || suggested == nil {
+		return p.human.HoldAuction(view)
+	}
+	artist := suggested.ArtPiece.Artist
+	expectedValue, expectedBid := p.advisor.ExpectedValue(view, artist), p.advisor.ExpectedBid(view, artist)
+	fmt.Fprintf(p.out, "Advisor suggests auctioning %s\n", strArtPieces(suggested.ArtPieces()))
+	fmt.Fprintf(p.out, "  expected value %d, expected bid %d\n", expectedValue, expectedBid)
+	if p.accept() {
+		suggested.Auctioneer = p
+		suggested.WinningBid = game.NewBid(p, 0)
+		return suggested, nil
+	}
+
+	auction, err := p.human.HoldAuction(view)
+	if err != nil || auction == nil {
+		return auction, err
+	}
+	p.override(view, game.DecisionHoldAuction, nil, expectedValue, expectedBid,
+		&Choice{ArtPiece: suggested.ArtPiece, SecondArtPiece: suggested.SecondArtPiece},
+		&Choice{ArtPiece: auction.ArtPiece, SecondArtPiece: auction.SecondArtPiece})
+	return auction, nil
+}
+
+// OfferDouble suggests the AlphaPlayer's ArtPiece to add to a double Auction, if any
+func (p *AdvisorPlayer) OfferDouble(view *game.GameView, auction *game.Auction) (*game.ArtPiece, error) {
+	suggested, err := p.advisor.OfferDouble(view, auction)
+	if err != nil {
+		return p.human.OfferDouble(view, auction)
+	}
+	expectedValue, expectedBid := p.expectations(view, auction)
+	if suggested != nil {
+		fmt.Fprintf(p.out, "Advisor suggests adding %s to the double\n", strArtPiece(suggested))
+	} else {
+		fmt.Fprintf(p.out, "Advisor suggests declining the double\n")
+	}
+	fmt.Fprintf(p.out, "  expected value %d, expected bid %d\n", expectedValue, expectedBid)
+	if p.accept() {
+		return suggested, nil
+	}
+
+	artPiece, err := p.human.OfferDouble(view, auction)
+	if err != nil {
+		return artPiece, err
+	}
+	p.override(view, game.DecisionOfferDouble, auction, expectedValue, expectedBid, &Choice{ArtPiece: suggested}, &Choice{ArtPiece: artPiece})
+	return artPiece, nil
+}
+
+// SetPrice suggests the AlphaPlayer's price
+func (p *AdvisorPlayer) SetPrice(view *game.GameView, auction *game.Auction) (int, error) {
+	suggested, err := p.advisor.SetPrice(view, auction)
+	if err != nil {
+		return p.human.SetPrice(view, auction)
+	}
+	expectedValue, expectedBid := p.expectations(view, auction)
+	fmt.Fprintf(p.out, "Advisor suggests a price of %d\n", suggested)
+	fmt.Fprintf(p.out, "  expected value %d, expected bid %d\n", expectedValue, expectedBid)
+	if p.accept() {
+		return suggested, nil
+	}
+
+	price, err := p.human.SetPrice(view, auction)
+	if err != nil {
+		return price, err
+	}
+	p.override(view, game.DecisionSetPrice, auction, expectedValue, expectedBid, &Choice{Value: suggested}, &Choice{Value: price})
+	return price, nil
+}
+
+// Bid suggests the AlphaPlayer's bid
+func (p *AdvisorPlayer) Bid(view *game.GameView, auction *game.Auction) (*game.Bid, error) {
+	suggested, err := p.advisor.Bid(view, auction)
+	if err != nil {
+		return p.human.Bid(view, auction)
+	}
+	expectedValue, expectedBid := p.expectations(view, auction)
+	if suggested == nil {
+		fmt.Fprintf(p.out, "Advisor suggests declining\n")
+	} else {
+		fmt.Fprintf(p.out, "Advisor suggests bidding %d\n", suggested.Value)
+	}
+	fmt.Fprintf(p.out, "  expected value %d, expected bid %d\n", expectedValue, expectedBid)
+	if p.accept() {
+		if suggested == nil {
+			return nil, nil
+		}
+		return game.NewBid(p, suggested.Value), nil
+	}
+
+	bid, err := p.human.Bid(view, auction)
+	if err != nil {
+		return bid, err
+	}
+	p.override(view, game.DecisionBid, auction, expectedValue, expectedBid, bidChoice(suggested), bidChoice(bid))
+	if bid == nil {
+		return nil, nil
+	}
+	return game.NewBid(p, bid.Value), nil
+}
+
+// bidChoice returns the Choice of a Bid, which declines if it is nil
+func bidChoice(bid *game.Bid) *Choice {
+	if bid == nil {
+		return &Choice{Decline: true}
+	}
+	return &Choice{Value: bid.Value}
+}
+
+// OpenBid suggests the AlphaPlayer's move in an open Auction
+func (p *AdvisorPlayer) OpenBid(view *game.GameView, auction *game.Auction, going game.Going) (*game.OpenBid, error) {
+	suggested, err := p.advisor.OpenBid(view, auction, going)
+	if err != nil || suggested == nil {
+		return p.human.OpenBid(view, auction, going)
+	}
+	expectedValue, expectedBid := p.expectations(view, auction)
+	if suggested.Type == game.OpenBidRaise {
+		fmt.Fprintf(p.out, "Advisor suggests raising to %d\n", suggested.Bid.Value)
+	} else {
+		fmt.Fprintf(p.out, "Advisor suggests to %s\n", suggested.Type)
+	}
+	fmt.Fprintf(p.out, "  expected value %d, expected bid %d\n", expectedValue, expectedBid)
+	if p.accept() {
+		if suggested.Type == game.OpenBidRaise {
+			return game.NewRaise(game.NewBid(p, suggested.Bid.Value)), nil
+		}
+		return suggested, nil
+	}
+
+	openBid, err := p.human.OpenBid(view, auction, going)
+	if err != nil || openBid == nil {
+		return openBid, err
+	}
+	if openBid.Type == game.OpenBidRaise && openBid.Bid != nil {
+		openBid = game.NewRaise(game.NewBid(p, openBid.Bid.Value))
+	}
+	p.override(view, game.DecisionOpenBid, auction, expectedValue, expectedBid, openBidChoice(suggested), openBidChoice(openBid))
+	return openBid, nil
+}
+
+// HandleAuctionResult passes the result to the human
+func (p *AdvisorPlayer) HandleAuctionResult(auction *game.Auction) {
+	p.human.HandleAuctionResult(auction)
+}
+
+// AddArtPieces passes the ArtPieces to the human
+func (p *AdvisorPlayer) AddArtPieces(pieces []*game.ArtPiece) {
+	p.human.AddArtPieces(pieces)
+}
+
+// MoveMoney passes the money to the human
+func (p *AdvisorPlayer) MoveMoney(amount int) {
+	p.human.MoveMoney(amount)
+}
+
+// expectations returns the advisor's ExpectedValue and ExpectedBid for the ArtPieces of the Auction,
+// valued as they were before they were put up for Auction
+func (p *AdvisorPlayer) expectations(view *game.GameView, auction *game.Auction) (int, int) {
+	phase := phaseBefore(view, auction)
+	expectedValue, expectedBid := 0, 0
+	for _, artPiece := range auction.ArtPieces() {
+		expectedValue += p.advisor.expectedValue(view, phase, artPiece.Artist)
+		expectedBid += p.advisor.expectedBid(view, phase, artPiece.Artist)
+	}
+	return expectedValue, expectedBid
+}
+
+// accept asks the human whether to take the suggestion. An empty line accepts and anything else overrides.
+func (p *AdvisorPlayer) accept() bool {
+	fmt.Fprintf(p.out, "Press Enter to accept, or enter o to decide yourself:\n")
+	line, err := readLine(p.in)
+	if err != nil && line == "" {
+		// without input there is no one to override the advisor
+		return true
+	}
+	return strings.TrimSpace(line) == ""
+}
+
+// override records an Override of the decision, unless the human chose what the advisor suggested anyway
+func (p *AdvisorPlayer) override(view *game.GameView, decision game.Decision, auction *game.Auction, expectedValue int, expectedBid int, suggested *Choice, chosen *Choice) {
+	if sameChoice(suggested, chosen) {
+		return
+	}
+	o := &Override{
+		Player:        p.Name(),
+		Phase:         view.CurrentPhase(),
+		Decision:      decision,
+		Money:         view.Money(),
+		ExpectedValue: expectedValue,
+		ExpectedBid:   expectedBid,
+		Suggested:     suggested,
+		Chosen:        chosen,
+	}
+	if auction != nil {
+		o.Auction = game.NewAuctionSnapshot(auction)
+	}
+	p.overrides = append(p.overrides, o)
+	if p.log != nil {
+		_ = p.log.Encode(o)
+	}
+}
+
+// sameChoice returns true if the Choices are the same decision. ArtPieces are compared by name.
+func sameChoice(a *Choice, b *Choice) bool {
+	return sameArtPiece(a.ArtPiece, b.ArtPiece) && sameArtPiece(a.SecondArtPiece, b.SecondArtPiece) &&
+		a.Value == b.Value && a.OpenBid == b.OpenBid && a.Decline == b.Decline
+}
+
+// sameArtPiece returns true if both ArtPieces are nil or have the same name
+func sameArtPiece(a *game.ArtPiece, b *game.ArtPiece) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Name == b.Name
+}
+
+// openBidChoice returns the Choice of an OpenBid
+func openBidChoice(openBid *game.OpenBid) *Choice {
+	choice := &Choice{OpenBid: openBid.Type}
+	if openBid.Bid != nil {
+		choice.Value = openBid.Bid.Value
+	}
+	return choice
+}
+
+// readLine reads up to the next newline one byte at a time, so that nothing after it is consumed
+func readLine(r io.Reader) (string, error) {
+	var line []byte
+	b := make([]byte, 1)
+	for {
+		n, err := r.Read(b)
+		if n == 1 {
+			if b[0] == '\n' {
+				return string(line), nil
+			}
+			line = append(line, b[0])
+		}
+		if err != nil {
+			return string(line), err
+		}
+	}
+}
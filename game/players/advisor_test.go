@@ -0,0 +1,175 @@
+package players_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/SachinMeier/modern-art.git/game"
+	"github.com/SachinMeier/modern-art.git/game/players"
+	"github.com/stretchr/testify/suite"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestAdvisorPlayerSuite(t *testing.T) {
+	suite.Run(t, new(AdvisorPlayerTestSuite))
+}
+
+type AdvisorPlayerTestSuite struct {
+	suite.Suite
+	testCtx    context.Context
+	cancelFunc context.CancelFunc
+}
+
+func (suite *AdvisorPlayerTestSuite) SetupSuite() {}
+
+func (suite *AdvisorPlayerTestSuite) SetupTest() {
+	suite.testCtx, suite.cancelFunc = context.WithCancel(context.Background())
+}
+
+func (suite *AdvisorPlayerTestSuite) TearDownTest() {
+	suite.cancelFunc()
+}
+
+func (suite *AdvisorPlayerTestSuite) TearDownSuite() {}
+
+func (suite *AdvisorPlayerTestSuite) Test_Accept() {
+	// 1. Test that a human who accepts every suggestion plays like the AlphaPlayer
+	{
+		advisor := suite.newAdvisor(strings.Repeat("\n", 10000))
+		scores := suite.play(advisor)
+		suite.Equal(suite.play(players.NewAlphaPlayer("human")), scores)
+		suite.Empty(advisor.Overrides())
+	}
+
+	// 2. Test that suggestions are accepted once the input ends
+	{
+		advisor := suite.newAdvisor("")
+		scores := suite.play(advisor)
+		suite.Equal(suite.play(players.NewAlphaPlayer("human")), scores)
+		suite.Empty(advisor.Overrides())
+	}
+}
+
+func (suite *AdvisorPlayerTestSuite) Test_Override() {
+	// 1. Test that a human who overrides every suggestion plays their own decisions, and that each override is logged
+	{
+		advisor := suite.newAdvisor(strings.Repeat("o\n", 10000))
+		var log bytes.Buffer
+		advisor.SetOverrideLog(&log)
+		scores := suite.play(advisor)
+		suite.Equal(suite.play(players.NewDummyPlayer("human")), scores)
+
+		overrides := advisor.Overrides()
+		suite.Require().NotEmpty(overrides)
+		decisions := make(map[game.Decision]int)
+		for _, override := range overrides {
+			decisions[override.Decision]++
+			suite.Equal("human", override.Player)
+			suite.NotNil(override.Suggested)
+			suite.NotNil(override.Chosen)
+			suite.NotEqual(override.Suggested, override.Chosen)
+			if override.Decision == game.DecisionHoldAuction {
+				suite.Nil(override.Auction)
+				suite.NotNil(override.Suggested.ArtPiece)
+				suite.NotNil(override.Chosen.ArtPiece)
+			} else {
+				suite.NotNil(override.Auction)
+			}
+		}
+		suite.Greater(decisions[game.DecisionHoldAuction], 0)
+		suite.Greater(decisions[game.DecisionBid], 0)
+
+		logged := []*players.Override{}
+		decoder := json.NewDecoder(&log)
+		for {
+			override := &players.Override{}
+			if err := decoder.Decode(override); err == io.EOF {
+				break
+			} else {
+				suite.Require().NoError(err)
+			}
+			logged = append(logged, override)
+		}
+		suite.Equal(len(overrides), len(logged))
+		suite.Equal(overrides[0].Suggested, logged[0].Suggested)
+	}
+
+	// 2. Test that the answer to a suggestion is read up to its newline only, leaving the rest to the human
+	{
+		in := strings.NewReader("o\nrest\n")
+		advisor := players.NewAdvisorPlayer(players.NewAlphaPlayer("human"))
+		advisor.SetInput(in)
+		advisor.SetOutput(io.Discard)
+		ng, err := game.NewGame([]game.Player{advisor, players.NewAlphaPlayer("alpha-1"), players.NewAlphaPlayer("alpha-2")}, game.WithSeed(5))
+		suite.Require().NoError(err)
+		ng.LookupGamePlayer("human").Hand = []*game.ArtPiece{game.NewArtPiece(game.Manuel, "manuel-1")}
+
+		auction, err := advisor.HoldAuction(ng.View("human"))
+		suite.Require().NoError(err)
+		suite.Equal("manuel-1", auction.ArtPiece.Name)
+		rest, err := io.ReadAll(in)
+		suite.Require().NoError(err)
+		suite.Equal("rest\n", string(rest))
+	}
+
+	// 3. Test that a human who overrides a suggestion but makes the same decision is not logged
+	{
+		advisor := players.NewAdvisorPlayer(players.NewAlphaPlayer("human"))
+		advisor.SetInput(strings.NewReader(strings.Repeat("o\n", 10000)))
+		advisor.SetOutput(io.Discard)
+		var log bytes.Buffer
+		advisor.SetOverrideLog(&log)
+		scores := suite.play(advisor)
+		suite.Equal(suite.play(players.NewAlphaPlayer("human")), scores)
+		suite.Empty(advisor.Overrides())
+		suite.Empty(log.String())
+	}
+
+	// 4. Test that a human who declines a set price the advisor suggested accepting is logged as declining
+	{
+		advisor := players.NewAdvisorPlayer(&decliner{DummyPlayer: players.NewDummyPlayer("human")})
+		advisor.SetInput(strings.NewReader("o\n"))
+		advisor.SetOutput(io.Discard)
+		ng, err := game.NewGame([]game.Player{advisor, players.NewAlphaPlayer("alpha-1"), players.NewAlphaPlayer("alpha-2")}, game.WithSeed(5))
+		suite.Require().NoError(err)
+		alpha := ng.LookupGamePlayer("alpha-1").Player
+		auction := game.NewAuction(alpha, game.NewArtPieceWithAuctionType(game.Manuel, "manuel-1", game.AuctionTypeSetPrice), game.NewBid(alpha, 1))
+
+		bid, err := advisor.Bid(ng.View("human"), auction)
+		suite.Require().NoError(err)
+		suite.Nil(bid)
+		suite.Require().Len(advisor.Overrides(), 1)
+		suite.Equal(&players.Choice{Value: 1}, advisor.Overrides()[0].Suggested)
+		suite.Equal(&players.Choice{Decline: true}, advisor.Overrides()[0].Chosen)
+	}
+}
+
+// helpers
+
+// decliner is a DummyPlayer that declines every set price
+type decliner struct {
+	*players.DummyPlayer
+}
+
+func (p *decliner) Bid(*game.GameView, *game.Auction) (*game.Bid, error) {
+	return nil, nil
+}
+
+// newAdvisor creates an AdvisorPlayer named human for a DummyPlayer, answering suggestions from the input
+func (suite *AdvisorPlayerTestSuite) newAdvisor(input string) *players.AdvisorPlayer {
+	advisor := players.NewAdvisorPlayer(players.NewDummyPlayer("human"))
+	advisor.SetInput(strings.NewReader(input))
+	advisor.SetOutput(io.Discard)
+	return advisor
+}
+
+// play plays a seeded Game of the Player against two AlphaPlayers and returns the scores
+func (suite *AdvisorPlayerTestSuite) play(p game.Player) map[string]int {
+	ng, err := game.NewGame([]game.Player{p, players.NewAlphaPlayer("alpha-1"), players.NewAlphaPlayer("alpha-2")}, game.WithSeed(5))
+	suite.Require().NoError(err)
+	result, err := ng.Start()
+	suite.Require().NoError(err)
+	return result.Scores()
+}
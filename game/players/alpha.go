@@ -11,17 +11,7 @@ and will most likely always play the artist in first place.
 It will bid pretty close to the expected value of the artist.
 */
 type AlphaPlayer struct {
-	name       string
-	hand       map[game.Artist][]*game.ArtPiece
-	collection map[game.Artist][]*game.ArtPiece
-	money      int
-
-	// TODO: possibly make this a map[game.Player][]*game.ArtPiece
-	otherCollections [][]*game.ArtPiece
-
-	currentPhase *game.Phase
-	phases       []*game.Phase
-	phasePayouts []map[game.Artist]int
+	name string
 }
 
 // Ensures that AlphaPlayer implements game.Player interface at compile time
@@ -30,15 +20,7 @@ var _ game.Player = &AlphaPlayer{}
 // NewAlphaPlayer creates a new AlphaPlayer
 func NewAlphaPlayer(name string) *AlphaPlayer {
 	return &AlphaPlayer{
-		name:       name,
-		hand:       make(map[game.Artist][]*game.ArtPiece),
-		collection: make(map[game.Artist][]*game.ArtPiece),
-		money:      0,
-
-		otherCollections: make([][]*game.ArtPiece, 0),
-		currentPhase:     game.NewPhase(),
-		phases:           make([]*game.Phase, 0),
-		phasePayouts:     make([]map[game.Artist]int, 0),
+		name: name,
 	}
 }
 
@@ -53,11 +35,17 @@ func (p *AlphaPlayer) SetName(name string) {
 
 // Auctioning
 
-func (p *AlphaPlayer) HoldAuction() (*game.Auction, error) {
+// HoldAuction auctions the artist with the highest ExpectedValue in the hand
+func (p *AlphaPlayer) HoldAuction(view *game.GameView) (*game.Auction, error) {
+	hand := handByArtist(view.Hand())
 	artistToSell := game.ArtistNone
 	maxExpectedValue := math.MinInt
-	for artist, _ := range p.hand {
-		expectedValue := p.ExpectedValue(artist)
+	// artists are considered in a fixed order so that ties are broken the same way every time
+	for _, artist := range game.AllArtists() {
+		if len(hand[artist]) == 0 {
+			continue
+		}
+		expectedValue := p.ExpectedValue(view, artist)
 		if expectedValue > maxExpectedValue {
 			maxExpectedValue = expectedValue
 			artistToSell = artist
@@ -68,37 +56,80 @@ func (p *AlphaPlayer) HoldAuction() (*game.Auction, error) {
 		return nil, game.ErrNoArtPieceToSell
 	}
 
-	return game.NewAuction(p,
-		// TODO: for now, sell first art piece of artist. Change when introducing
-		// diff auction types
-		p.hand[artistToSell][0],
-		game.NewBid(p, 0),
-	), nil
+	// TODO: for now, sell first art piece of artist. Change when introducing
+	// diff auction types
+	artPiece := hand[artistToSell][0]
+	auction := game.NewAuction(p, artPiece, game.NewBid(p, 0))
+	if artPiece.IsDouble() {
+		auction.SecondArtPiece = secondArtPiece(hand[artistToSell])
+	}
+	return auction, nil
+}
+
+// OfferDouble adds a second ArtPiece to another Player's double Auction if
+// the Player expects to sell it for anything.
+func (p *AlphaPlayer) OfferDouble(view *game.GameView, auction *game.Auction) (*game.ArtPiece, error) {
+	if p.ExpectedBid(view, auction.ArtPiece.Artist) <= 0 {
+		return nil, nil
+	}
+	return secondArtPiece(handByArtist(view.Hand())[auction.ArtPiece.Artist]), nil
+}
+
+// handByArtist groups the ArtPieces of a hand by artist
+func handByArtist(artPieces []*game.ArtPiece) map[game.Artist][]*game.ArtPiece {
+	hand := make(map[game.Artist][]*game.ArtPiece)
+	for _, artPiece := range artPieces {
+		hand[artPiece.Artist] = append(hand[artPiece.Artist], artPiece)
+	}
+	return hand
+}
+
+// secondArtPiece returns the first non-double ArtPiece. Returns nil if there is none.
+func secondArtPiece(artPieces []*game.ArtPiece) *game.ArtPiece {
+	for _, artPiece := range artPieces {
+		if !artPiece.IsDouble() {
+			return artPiece
+		}
+	}
+	return nil
+}
+
+// ExpectedValue is the value to the Player of auctioning an ArtPiece by the artist now
+func (p *AlphaPlayer) ExpectedValue(view *game.GameView, artist game.Artist) int {
+	return p.expectedValue(view, view.Phase(), artist)
 }
 
-func (p *AlphaPlayer) ExpectedValue(artist game.Artist) int {
+// expectedValue is the value to the Player of auctioning an ArtPiece by the artist after the Auctions in the phase
+func (p *AlphaPlayer) expectedValue(view *game.GameView, phase *game.Phase, artist game.Artist) int {
 	competitivenessDelta := 0
 	selfDelta := 0
 	otherDelta := 0
-	expectedBid := p.ExpectedBid(artist)
+	expectedBid := p.expectedBid(view, phase, artist)
 	return competitivenessDelta*(selfDelta-otherDelta) + expectedBid
 }
 
-func (p *AlphaPlayer) ExpectedBid(artist game.Artist) int {
+// ExpectedBid is what the Player expects an ArtPiece by the artist to sell for if it is auctioned now
+func (p *AlphaPlayer) ExpectedBid(view *game.GameView, artist game.Artist) int {
+	return p.expectedBid(view, view.Phase(), artist)
+}
+
+// expectedBid is what the Player expects an ArtPiece by the artist to sell for
+// if it is auctioned after the Auctions in the phase
+func (p *AlphaPlayer) expectedBid(view *game.GameView, phase *game.Phase, artist game.Artist) int {
 	// if this art piece would end the round
-	if p.artistWouldEndRound(artist) {
+	if phase.EndsPhase(artist) {
 		return 0
 	}
-	competitiveness := p.calculateCompetitiveness(artist)
+	competitiveness := p.calculateCompetitiveness(view.Rules(), phase, artist)
 	//log.Printf("competitiveness for %s: %f\n", artist, competitiveness)
 	// normalize the competitiveness scale from 100 to the payout range
 	// should we also/instead normalize competitiveness based on the competitiveness of all other artists?
-	maxPayout := p.maxPayout(artist)
+	maxPayout := maxPayout(view, artist)
 	scale := float64(maxPayout) / 100.0
 	scaledComp := competitiveness * scale
 
 	// low certainty reduces player bids
-	expectedBid := scaledComp * uncertaintyFactor(artist, p.currentPhase)
+	expectedBid := scaledComp * uncertaintyFactor(artist, phase)
 	return nonNegative(int(math.Floor(expectedBid)))
 }
 
@@ -118,9 +149,9 @@ for (otherArtists):
 
 	±(nLead)^(1/3) * placeWeight
 */
-func (p *AlphaPlayer) calculateCompetitiveness(artist game.Artist) float64 {
+func (p *AlphaPlayer) calculateCompetitiveness(rules *game.RuleSet, phase *game.Phase, artist game.Artist) float64 {
 	// create a copy of the phase if the artist were to be played
-	hypotheticalPhase := p.currentPhase.Copy()
+	hypotheticalPhase := phase.Copy()
 	hypotheticalPhase.AddAuction(&game.Auction{
 		Auctioneer: p,
 		ArtPiece:   game.NewArtPiece(artist, "hypothetical"),
@@ -134,9 +165,9 @@ func (p *AlphaPlayer) calculateCompetitiveness(artist game.Artist) float64 {
 		based on how much more or less we have than the other artists.
 	*/
 	tiebreakerScaleFactor := 0.75
-	n := hypotheticalPhase.ArtistCounts[artist] + int(float64(game.Tiebreakers[artist])*tiebreakerScaleFactor)
+	n := hypotheticalPhase.ArtistCounts[artist] + int(float64(rules.Tiebreakers[artist])*tiebreakerScaleFactor)
 
-	artPieceBaseFactor := int(100.0 / float64(game.MaxArtPiecePointsPerPhase))
+	artPieceBaseFactor := int(100.0 / float64(rules.EndPhasePoints()))
 
 	justPlayedBoost := 10.0
 	competitiveness := float64(n*artPieceBaseFactor) + justPlayedBoost
@@ -144,7 +175,7 @@ func (p *AlphaPlayer) calculateCompetitiveness(artist game.Artist) float64 {
 
 	// since we're considering playing this artist, it will get a boost of
 	// one more art piece
-	//newPieceBaseFactor := float64(game.MaxArtPiecePointsPerPhase) / 10.0
+	//newPieceBaseFactor := float64(rules.EndPhasePoints()) / 10.0
 	// scales up/down how much each comparison matters
 	compScaleFactor := 4.0
 	for i, ct := range rankedArtistCounts(hypotheticalPhase) {
@@ -156,7 +187,7 @@ func (p *AlphaPlayer) calculateCompetitiveness(artist game.Artist) float64 {
 		// how much more does other artist have than self
 		// divide by Points to see how many pcs diff between self and other
 
-		nLead := float64(n-ct.Count) / float64(game.MaxArtPiecePointsPerPhase)
+		nLead := float64(n-ct.Count) / float64(rules.EndPhasePoints())
 		//fmt.Printf("lead vs %s for %s: %f (%d - %d)\n", ct.Artist, artist, nLead, n, ct.Count)
 
 		// take cube root of nLead to get a diminishing return
@@ -258,76 +289,112 @@ func placeWeights(otherRank int, val float64) float64 {
 	}
 }
 
-func (p *AlphaPlayer) artistWouldEndRound(artist game.Artist) bool {
-	return p.currentPhase.ArtistCounts[artist]+game.PointsPerArtPiece >= game.MaxArtPiecePointsPerPhase
+func maxPayout(view *game.GameView, artist game.Artist) int {
+	return view.ArtistValues()[artist] + view.Rules().RankPayouts[0]
 }
 
-func (p *AlphaPlayer) pastPayoutSum(artist game.Artist) int {
-	pastPayoutSum := 0
-	for _, phasePayout := range p.phasePayouts {
-		pastPayoutSum += phasePayout[artist]
-	}
-	return pastPayoutSum
+func averagePayout(view *game.GameView, artist game.Artist) float64 {
+	pastPayoutSum := view.ArtistValues()[artist]
+	// possible payouts are 0 or pastPayoutSum plus one of the rank payouts
+	// simplified to n * pastPayoutSum + the sum of the rank payouts
+	rankPayouts := view.Rules().RankPayouts
+	return float64(len(rankPayouts)*pastPayoutSum+rankPayoutSum(rankPayouts)) / float64(len(rankPayouts)+1)
 }
 
-func (p *AlphaPlayer) maxPayout(artist game.Artist) int {
-	pastPayoutSum := p.pastPayoutSum(artist)
-	return pastPayoutSum + game.RankPayout1
+func rankPayoutSum(rankPayouts []int) int {
+	sum := 0
+	for _, payout := range rankPayouts {
+		sum += payout
+	}
+	return sum
 }
 
-func (p *AlphaPlayer) averagePayout(artist game.Artist) float64 {
-	pastPayoutSum := p.pastPayoutSum(artist)
-	// possible payouts are always 0, pastPayoutSum+10, pastPayoutSum+20, pastPayoutSum+30
-	// simplified to 3 * pastPayoutSum + 60
-	return float64(3*pastPayoutSum+rankPayoutSum()) / 4.0
-}
+// Bidding
 
-func rankPayoutSum() int {
-	return game.RankPayout1 + game.RankPayout2 + game.RankPayout3
+// SetPrice sets the price to the max bid, so the Player is happy to buy at their own price
+func (p *AlphaPlayer) SetPrice(view *game.GameView, auction *game.Auction) (int, error) {
+	return p.maxBid(view, auction), nil
 }
 
-// Bidding
-
-func (p *AlphaPlayer) Bid(auction *game.Auction) (*game.Bid, error) {
+// Bid bids on one-shot, blind and set-price Auctions based on the max bid
+func (p *AlphaPlayer) Bid(view *game.GameView, auction *game.Auction) (*game.Bid, error) {
 	switch auction.Type {
 	case game.AuctionTypeOneShot:
-		return p.bidOneShot(auction)
+		return p.bidOneShot(view, auction)
 	case game.AuctionTypeBlind:
-		return p.bidBlind(auction)
+		return p.bidBlind(view, auction)
 	case game.AuctionTypeSetPrice:
-		return p.bidSetPrice(auction)
+		return p.bidSetPrice(view, auction)
+	default:
+		return game.NewBid(p, 0), nil
 	}
 }
 
-func (p *AlphaPlayer) OpenBid(auction *game.Auction, recv <-chan *game.Bid, send chan<- *game.Bid) {
-	return // not implemented
+// bidOneShot bids the max bid if it beats the current winning bid
+func (p *AlphaPlayer) bidOneShot(view *game.GameView, auction *game.Auction) (*game.Bid, error) {
+	maxBid := p.maxBid(view, auction)
+	if auction.WinningBid != nil && maxBid <= auction.WinningBid.Value {
+		return game.NewBid(p, 0), nil
+	}
+	return game.NewBid(p, maxBid), nil
 }
 
-func (p *AlphaPlayer) HandleAuctionResult(auction *game.Auction) {
-	p.currentPhase.AddAuction(auction)
-	if p.currentPhase.IsOver() {
-		p.phases = append(p.phases, p.currentPhase)
-		p.phasePayouts = append(p.phasePayouts, game.CumulativePayouts(p.phases))
-		p.currentPhase = game.NewPhase()
+// bidBlind always bids the max bid since other bids are unknown
+func (p *AlphaPlayer) bidBlind(view *game.GameView, auction *game.Auction) (*game.Bid, error) {
+	return game.NewBid(p, p.maxBid(view, auction)), nil
+}
+
+// bidSetPrice accepts the set price if it is at most the max bid, and declines with a nil Bid otherwise
+func (p *AlphaPlayer) bidSetPrice(view *game.GameView, auction *game.Auction) (*game.Bid, error) {
+	price := auction.WinningBid.Value
+	if price <= p.maxBid(view, auction) {
+		return game.NewBid(p, price), nil
 	}
-	if auction.WinningBid.Bidder.Name() == p.name {
-		// add the ArtPiece to their collection
-		p.collection[auction.ArtPiece.Artist] = append(p.collection[auction.ArtPiece.Artist], auction.ArtPiece)
+	return nil, nil
+}
+
+// maxBid is the most the Player is willing to pay for all ArtPieces in the Auction
+func (p *AlphaPlayer) maxBid(view *game.GameView, auction *game.Auction) int {
+	phase := phaseBefore(view, auction)
+	value := 0
+	for _, artPiece := range auction.ArtPieces() {
+		value += p.expectedBid(view, phase, artPiece.Artist)
+	}
+	if value > view.Money() {
+		return view.Money()
 	}
+	return value
 }
 
-// AddArtPieces adds ArtPiece's to the Player's hand
-func (p *AlphaPlayer) AddArtPieces(pieces []*game.ArtPiece) {
-	for _, piece := range pieces {
-		if val, ok := p.hand[piece.Artist]; !ok {
-			p.hand[piece.Artist] = []*game.ArtPiece{piece}
-		} else {
-			p.hand[piece.Artist] = append(val, piece)
+// phaseBefore returns the view's Phase without the Auction. The view's Phase includes the Auction being
+// decided on, which is valued as if it had not been played yet.
+func phaseBefore(view *game.GameView, auction *game.Auction) *game.Phase {
+	phase := view.Rules().NewPhase()
+	for _, played := range view.Phase().Auctions {
+		if played.ArtPiece.Name != auction.ArtPiece.Name {
+			phase.AddAuction(played)
 		}
 	}
+	return phase
 }
 
-// MoveMoney gives the Player money. Currently only used for payouts.
-func (p *AlphaPlayer) MoveMoney(amount int) {
-	p.money += amount
+// OpenBid outbids the standing bid by 1 until the max bid is reached, then withdraws from the Auction.
+// An auctioneer holding the standing bid closes the Auction.
+func (p *AlphaPlayer) OpenBid(view *game.GameView, auction *game.Auction, going game.Going) (*game.OpenBid, error) {
+	if auction.WinningBid.Bidder.Name() == p.name {
+		return game.NewClose(), nil
+	}
+	if auction.WinningBid.Value >= p.maxBid(view, auction) {
+		return game.NewWithdrawal(), nil
+	}
+	return game.NewRaise(game.NewBid(p, auction.WinningBid.Value+1)), nil
 }
+
+// HandleAuctionResult does nothing. The Player reads the results from the GameView.
+func (p *AlphaPlayer) HandleAuctionResult(auction *game.Auction) {}
+
+// AddArtPieces does nothing. The Player reads their hand from the GameView.
+func (p *AlphaPlayer) AddArtPieces(pieces []*game.ArtPiece) {}
+
+// MoveMoney does nothing. The Player reads their money from the GameView.
+func (p *AlphaPlayer) MoveMoney(amount int) {}
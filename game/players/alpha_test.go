@@ -2,6 +2,7 @@ package players_test
 
 import (
 	"context"
+	"fmt"
 	"github.com/SachinMeier/modern-art.git/game"
 	"github.com/SachinMeier/modern-art.git/game/players"
 	"github.com/stretchr/testify/suite"
@@ -34,7 +35,8 @@ func (suite *AlphaPlayerTestSuite) Test_HoldAuction() {
 	// 1. Test that player returns no auction if he has no art pieces
 	{
 		p1 := players.NewAlphaPlayer("alpha-1")
-		auction, err := p1.HoldAuction()
+		ng := suite.newGame(p1)
+		auction, err := p1.HoldAuction(ng.View("alpha-1"))
 		suite.Nil(auction)
 		suite.ErrorIs(err, game.ErrNoArtPieceToSell)
 	}
@@ -42,13 +44,120 @@ func (suite *AlphaPlayerTestSuite) Test_HoldAuction() {
 	// 1. Test that player sells only artist he has
 	{
 		p1 := players.NewAlphaPlayer("alpha-1")
+		ng := suite.newGame(p1)
 		m1 := game.NewArtPiece(game.Manuel, "manuel-1")
-		p1.AddArtPieces([]*game.ArtPiece{&m1})
+		ng.LookupGamePlayer("alpha-1").Hand = []*game.ArtPiece{m1}
 
-		auction, err := p1.HoldAuction()
+		auction, err := p1.HoldAuction(ng.View("alpha-1"))
 		if err != nil {
 			suite.FailNow("failed to hold auction", err.Error())
 		}
-		suite.Equal(&m1, auction.ArtPiece)
+		suite.Equal(m1, auction.ArtPiece)
 	}
 }
+
+func (suite *AlphaPlayerTestSuite) Test_HoldDoubleAuction() {
+	// 1. Test that player attaches a second art piece of the same artist to a double
+	{
+		p1 := players.NewAlphaPlayer("alpha-1")
+		ng := suite.newGame(p1)
+		double := game.NewArtPieceWithAuctionType(game.Manuel, "manuel-double", game.AuctionTypeDouble)
+		second := game.NewArtPieceWithAuctionType(game.Manuel, "manuel-2", game.AuctionTypeOpen)
+		ng.LookupGamePlayer("alpha-1").Hand = []*game.ArtPiece{double, second}
+
+		auction, err := p1.HoldAuction(ng.View("alpha-1"))
+		if err != nil {
+			suite.FailNow("failed to hold auction", err.Error())
+		}
+		suite.Equal(double, auction.ArtPiece)
+		suite.Equal(second, auction.SecondArtPiece)
+	}
+
+	// 2. Test that player never offers a double as the second art piece
+	{
+		p1 := players.NewAlphaPlayer("alpha-1")
+		p2 := players.NewAlphaPlayer("alpha-2")
+		ng := suite.newGame(p1, p2)
+		double1 := game.NewArtPieceWithAuctionType(game.Manuel, "manuel-double-1", game.AuctionTypeDouble)
+		double2 := game.NewArtPieceWithAuctionType(game.Manuel, "manuel-double-2", game.AuctionTypeDouble)
+		ng.LookupGamePlayer("alpha-1").Hand = []*game.ArtPiece{double2}
+
+		artPiece, err := p1.OfferDouble(ng.View("alpha-1"), game.NewAuction(p2, double1, game.NewBid(p2, 0)))
+		suite.NoError(err)
+		suite.Nil(artPiece)
+	}
+}
+
+func (suite *AlphaPlayerTestSuite) Test_Bid() {
+	// 1. Test that player never bids more than their money
+	{
+		p1 := players.NewAlphaPlayer("alpha-1")
+		p2 := players.NewAlphaPlayer("alpha-2")
+		ng := suite.newGame(p1, p2)
+		ng.LookupGamePlayer("alpha-1").Money = 1
+		m1 := game.NewArtPieceWithAuctionType(game.Manuel, "manuel-1", game.AuctionTypeBlind)
+
+		bid, err := p1.Bid(ng.View("alpha-1"), game.NewAuction(p2, m1, game.NewBid(p2, 0)))
+		suite.NoError(err)
+		suite.LessOrEqual(bid.Value, 1)
+	}
+
+	// 2. Test that player expects an artist who would end the phase to sell for nothing
+	{
+		p1 := players.NewAlphaPlayer("alpha-1")
+		ng := suite.newGame(p1)
+		suite.Positive(p1.ExpectedBid(ng.View("alpha-1"), game.Manuel))
+
+		phase := &game.PhaseSnapshot{ArtistCounts: map[game.Artist]int{game.Manuel: game.Point(4)}}
+		for i := 0; i < 4; i++ {
+			phase.Auctions = append(phase.Auctions, &game.AuctionSnapshot{
+				Auctioneer: "alpha-1",
+				Type:       game.AuctionTypeOneShot,
+				ArtPiece:   game.NewArtPiece(game.Manuel, fmt.Sprintf("manuel-%d", i)),
+				WinningBid: &game.BidSnapshot{Bidder: "alpha-1", Value: 10},
+			})
+		}
+		snapshot, err := ng.Snapshot()
+		suite.NoError(err)
+		snapshot.Phase = phase
+		resumed, err := game.ResumeGame(snapshot, suite.withDummies(p1))
+		suite.NoError(err)
+		suite.Equal(0, p1.ExpectedBid(resumed.View("alpha-1"), game.Manuel))
+		suite.Positive(p1.ExpectedBid(resumed.View("alpha-1"), game.Sigrid))
+	}
+
+	// 3. Test that player declines a set price above their max bid with a nil Bid, since a bid of 0 would buy at price 0
+	{
+		p1 := players.NewAlphaPlayer("alpha-1")
+		p2 := players.NewAlphaPlayer("alpha-2")
+		ng := suite.newGame(p1, p2)
+		ng.LookupGamePlayer("alpha-1").Money = 1
+		m1 := game.NewArtPieceWithAuctionType(game.Manuel, "manuel-1", game.AuctionTypeSetPrice)
+
+		bid, err := p1.Bid(ng.View("alpha-1"), game.NewAuction(p2, m1, game.NewBid(p2, 5)))
+		suite.NoError(err)
+		suite.Nil(bid)
+
+		bid, err = p1.Bid(ng.View("alpha-1"), game.NewAuction(p2, m1, game.NewBid(p2, 1)))
+		suite.NoError(err)
+		suite.Require().NotNil(bid)
+		suite.Equal(1, bid.Value)
+	}
+}
+
+// helpers
+
+// newGame creates a Game of the players with empty hands
+func (suite *AlphaPlayerTestSuite) newGame(ps ...game.Player) *game.Game {
+	ng, err := game.NewGame(suite.withDummies(ps...), game.WithSeed(7))
+	suite.Require().NoError(err)
+	return ng
+}
+
+// withDummies fills the empty seats of a 3-player Game with DummyPlayers
+func (suite *AlphaPlayerTestSuite) withDummies(ps ...game.Player) []game.Player {
+	for i := len(ps); i < 3; i++ {
+		ps = append(ps, players.NewDummyPlayer(fmt.Sprintf("dummy-%d", i)))
+	}
+	return ps
+}
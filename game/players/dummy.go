@@ -1,25 +1,28 @@
 package players
 
 import (
-	"crypto/rand"
 	"github.com/SachinMeier/modern-art.git/game"
-	"math/big"
+	"math/rand"
 )
 
-// DummyPlayer is a dummy player for testing
+// DummyPlayer is a dummy player for testing. It reads its hand and money from the GameView.
 type DummyPlayer struct {
 	name       string
-	Hand       []*game.ArtPiece
 	Collection []*game.ArtPiece
 	Money      int
+
+	rand *rand.Rand
 }
 
-// Ensures that DummyPlayer implements Player interface at compile time
-var _ game.Player = &DummyPlayer{}
+// Ensures that DummyPlayer implements RandPlayer interface at compile time
+var _ game.RandPlayer = &DummyPlayer{}
 
 // NewDummyPlayer creates a new DummyPlayer
 func NewDummyPlayer(name string) *DummyPlayer {
-	return &DummyPlayer{name: name}
+	return &DummyPlayer{
+		name: name,
+		rand: newRand(),
+	}
 }
 
 // Name returns the Player's name
@@ -32,78 +35,94 @@ func (dp *DummyPlayer) SetName(name string) {
 	dp.name = name
 }
 
-// HoldAuction returns the first card in their Hand
-func (dp *DummyPlayer) HoldAuction() (*game.Auction, error) {
-	// take first card in hand
-	artPiece := dp.Hand[0]
-	// remove it from the hand
-	dp.Hand = dp.Hand[1:]
-	return &game.Auction{
+// SetRand sets the Player's source of randomness. Called by the Game.
+func (dp *DummyPlayer) SetRand(rng *rand.Rand) {
+	dp.rand = rng
+}
+
+// HoldAuction returns the first card in their hand. If it is a double, the
+// first matching card in their hand is attached to it
+func (dp *DummyPlayer) HoldAuction(view *game.GameView) (*game.Auction, error) {
+	hand := view.Hand()
+	if len(hand) == 0 {
+		return nil, game.ErrNoArtPieceToSell
+	}
+	auction := &game.Auction{
 		Auctioneer: dp,
-		ArtPiece:   artPiece,
-	}, nil
+		ArtPiece:   hand[0],
+	}
+	if hand[0].IsDouble() {
+		auction.SecondArtPiece = findSecondArtPiece(hand[1:], hand[0].Artist)
+	}
+	return auction, nil
+}
+
+// OfferDouble adds the first matching card in their hand to the double Auction
+func (dp *DummyPlayer) OfferDouble(view *game.GameView, auction *game.Auction) (*game.ArtPiece, error) {
+	return findSecondArtPiece(view.Hand(), auction.ArtPiece.Artist), nil
+}
+
+// findSecondArtPiece returns the first non-double card by the artist in the hand.
+// Returns nil if there is none.
+func findSecondArtPiece(hand []*game.ArtPiece, artist game.Artist) *game.ArtPiece {
+	for _, artPiece := range hand {
+		if artPiece.Artist == artist && !artPiece.IsDouble() {
+			return artPiece
+		}
+	}
+	return nil
+}
+
+// SetPrice sets a random price up to half of their money
+func (dp *DummyPlayer) SetPrice(view *game.GameView, auction *game.Auction) (int, error) {
+	price := 0
+	if view.Money()/2 > 0 {
+		price = dp.rand.Intn(view.Money() / 2)
+	}
+	return price, nil
 }
 
 // Bid requests the Player to place a Bid on an Auction
-func (dp *DummyPlayer) Bid(auction *game.Auction) (*game.Bid, error) {
+func (dp *DummyPlayer) Bid(view *game.GameView, auction *game.Auction) (*game.Bid, error) {
 	// bid a random amount up to half of their money
-	amount, _ := randInt(dp.Money / 2)
+	amount := 0
+	if view.Money()/2 > 0 {
+		amount = dp.rand.Intn(view.Money() / 2)
+	}
 	return &game.Bid{
 		Bidder: dp,
 		Value:  amount,
 	}, nil
 }
 
-/*
-OpenBid decides a maxBid and always bids until they reach that maxBid or win the Auction
-*/
-func (p *DummyPlayer) OpenBid(auction *game.Auction, recv <-chan *game.Bid, send chan<- *game.Bid) {
-	maxBid, err := p.Bid(auction)
+// OpenBid draws a maxBid like Bid and outbids the standing bid by 1 if it is below the maxBid.
+// Otherwise, they withdraw from the Auction. An auctioneer holding the standing bid passes.
+func (dp *DummyPlayer) OpenBid(view *game.GameView, auction *game.Auction, going game.Going) (*game.OpenBid, error) {
+	if auction.WinningBid.Bidder.Name() == dp.name {
+		return game.NewPass(), nil
+	}
+	maxBid, err := dp.Bid(view, auction)
 	if err != nil {
-		close(send)
-		return
+		return nil, err
 	}
-	currBid := auction.WinningBid
-	more := true
-	for {
-		if !more {
-			close(send)
-			return
-		}
-		if currBid.Bidder.Name() != p.Name() && currBid.Value < maxBid.Value {
-			send <- p.oneUpBid(currBid)
-		}
-		currBid, more = <-recv
-	}
-
-}
-
-func (p *DummyPlayer) oneUpBid(bid *game.Bid) *game.Bid {
-	return &game.Bid{
-		Bidder: p,
-		Value:  bid.Value + 1,
+	if auction.WinningBid.Value >= maxBid.Value {
+		return game.NewWithdrawal(), nil
 	}
+	return game.NewRaise(game.NewBid(dp, auction.WinningBid.Value+1)), nil
 }
 
 // HandleAuctionResult informs the Player of the result of an game.Auction
 func (dp *DummyPlayer) HandleAuctionResult(auction *game.Auction) {
 	if auction.WinningBid.Bidder.Name() == dp.name {
-		// add the ArtPiece to their collection
-		dp.Collection = append(dp.Collection, auction.ArtPiece)
+		// add the ArtPieces to their collection
+		dp.Collection = append(dp.Collection, auction.ArtPieces()...)
 	}
 }
 
-// AddArtPieces adds ArtPiece's to the Player's Hand
-func (dp *DummyPlayer) AddArtPieces(pieces []*game.ArtPiece) {
-	dp.Hand = append(dp.Hand, pieces...)
-}
+// AddArtPieces does nothing. The Player reads their hand from the GameView.
+func (dp *DummyPlayer) AddArtPieces(pieces []*game.ArtPiece) {}
 
 // MoveMoney gives the Player money. Currently only used for payouts.
 func (dp *DummyPlayer) MoveMoney(amount int) {
 	dp.Money += amount
 }
-
-func randInt(max int) (int, error) {
-	i, err := rand.Int(rand.Reader, big.NewInt(int64(max)))
-	return int(i.Int64()), err
-}
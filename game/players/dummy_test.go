@@ -0,0 +1,86 @@
+package players_test
+
+import (
+	"context"
+	"github.com/SachinMeier/modern-art.git/game"
+	"github.com/SachinMeier/modern-art.git/game/players"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+func TestDummyPlayerSuite(t *testing.T) {
+	suite.Run(t, new(DummyPlayerTestSuite))
+}
+
+type DummyPlayerTestSuite struct {
+	suite.Suite
+	testCtx    context.Context
+	cancelFunc context.CancelFunc
+}
+
+func (suite *DummyPlayerTestSuite) SetupSuite() {}
+
+func (suite *DummyPlayerTestSuite) SetupTest() {
+	suite.testCtx, suite.cancelFunc = context.WithCancel(context.Background())
+}
+
+func (suite *DummyPlayerTestSuite) TearDownTest() {
+	suite.cancelFunc()
+}
+
+func (suite *DummyPlayerTestSuite) TearDownSuite() {}
+
+func (suite *DummyPlayerTestSuite) Test_HoldAuction() {
+	// 1. Test that player returns an error instead of an auction if their hand is empty
+	{
+		p1 := players.NewDummyPlayer("dummy-1")
+		ng := suite.newGame(p1)
+		auction, err := p1.HoldAuction(ng.View("dummy-1"))
+		suite.Nil(auction)
+		suite.ErrorIs(err, game.ErrNoArtPieceToSell)
+	}
+
+	// 2. Test that player auctions the first card of the hand in their view and attaches a second one to a double
+	{
+		p1 := players.NewDummyPlayer("dummy-1")
+		ng := suite.newGame(p1)
+		double := game.NewArtPieceWithAuctionType(game.Manuel, "manuel-double", game.AuctionTypeDouble)
+		sigrid := game.NewArtPiece(game.Sigrid, "sigrid-1")
+		second := game.NewArtPieceWithAuctionType(game.Manuel, "manuel-2", game.AuctionTypeOpen)
+		ng.LookupGamePlayer("dummy-1").Hand = []*game.ArtPiece{double, sigrid, second}
+
+		auction, err := p1.HoldAuction(ng.View("dummy-1"))
+		suite.Require().NoError(err)
+		suite.Equal(double, auction.ArtPiece)
+		suite.Equal(second, auction.SecondArtPiece)
+	}
+
+	// 3. Test that choosing cards leaves the player's hand to the Game
+	{
+		p1 := players.NewDummyPlayer("dummy-1")
+		ng := suite.newGame(p1)
+		m1 := game.NewArtPiece(game.Manuel, "manuel-1")
+		ng.LookupGamePlayer("dummy-1").Hand = []*game.ArtPiece{m1}
+
+		for i := 0; i < 2; i++ {
+			auction, err := p1.HoldAuction(ng.View("dummy-1"))
+			suite.Require().NoError(err)
+			suite.Equal(m1, auction.ArtPiece)
+		}
+		double := game.NewAuction(players.NewDummyPlayer("dummy-0"), game.NewArtPieceWithAuctionType(game.Manuel, "manuel-double", game.AuctionTypeDouble), nil)
+		for i := 0; i < 2; i++ {
+			artPiece, err := p1.OfferDouble(ng.View("dummy-1"), double)
+			suite.Require().NoError(err)
+			suite.Equal(m1, artPiece)
+		}
+	}
+}
+
+// helpers
+
+// newGame creates a seeded 3-player Game of DummyPlayers with the Player in the first seat
+func (suite *DummyPlayerTestSuite) newGame(p game.Player) *game.Game {
+	ng, err := game.NewGame([]game.Player{p, players.NewDummyPlayer("dummy-2"), players.NewDummyPlayer("dummy-3")}, game.WithSeed(7))
+	suite.Require().NoError(err)
+	return ng
+}
@@ -0,0 +1,14 @@
+package players
+
+import (
+	"fmt"
+)
+
+var (
+	ErrMissingView     = fmt.Errorf("request is missing the game view")
+	ErrMissingAuction  = fmt.Errorf("request is missing the auction")
+	ErrUnknownMessage  = fmt.Errorf("unknown message")
+	ErrProtocolVersion = fmt.Errorf("bot does not speak a supported protocol version")
+	ErrBotTimeout      = fmt.Errorf("bot did not reply in time")
+	ErrBotExited       = fmt.Errorf("bot process exited")
+)
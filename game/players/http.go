@@ -0,0 +1,86 @@
+package players
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/SachinMeier/modern-art.git/game"
+	"net/http"
+	"strings"
+)
+
+/*
+HTTPPlayer sends the messages of the remote protocol to a bot behind an HTTP endpoint. Each message is a
+POST of its RemoteRequest as JSON to the path of the message under the bot's base URL, and the bot answers
+with a RemoteResponse as JSON. NewHTTPHandler serves a local Player over the same protocol.
+*/
+
+// Paths of the HTTP protocol, under the bot's base URL
+const (
+	PathHoldAuction   = "/" + MessageHoldAuction
+	PathOfferDouble   = "/" + MessageOfferDouble
+	PathSetPrice      = "/" + MessageSetPrice
+	PathBid           = "/" + MessageBid
+	PathOpenBid       = "/" + MessageOpenBid
+	PathAuctionResult = "/" + MessageAuctionResult
+	PathAddArtPieces  = "/" + MessageAddArtPieces
+	PathMoveMoney     = "/" + MessageMoveMoney
+	PathGameOver      = "/" + MessageGameOver
+)
+
+// HTTPPlayer is a Player whose decisions are made by a bot over HTTP
+type HTTPPlayer struct {
+	*RemotePlayer
+	url    string
+	client *http.Client
+}
+
+// Ensures that HTTPPlayer implements ContextPlayer and ResultPlayer interfaces at compile time
+var (
+	_ game.ContextPlayer = &HTTPPlayer{}
+	_ game.ResultPlayer  = &HTTPPlayer{}
+)
+
+// NewHTTPPlayer creates a new HTTPPlayer for the bot at the base URL
+func NewHTTPPlayer(name string, url string) *HTTPPlayer {
+	p := &HTTPPlayer{
+		url:    strings.TrimSuffix(url, "/"),
+		client: &http.Client{},
+	}
+	p.RemotePlayer = NewRemotePlayer(name, p)
+	return p
+}
+
+// SetClient sets the http.Client used to reach the bot
+func (p *HTTPPlayer) SetClient(client *http.Client) {
+	p.client = client
+}
+
+// Send posts the request to the path of the message and reads the response.
+// A response with a status other than 2xx is an error.
+func (p *HTTPPlayer) Send(ctx context.Context, message string, req *RemoteRequest) (*RemoteResponse, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url+"/"+message, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpResp, err := p.client.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	resp := &RemoteResponse{}
+	if err := json.NewDecoder(httpResp.Body).Decode(resp); err != nil && httpResp.StatusCode/100 == 2 {
+		return nil, fmt.Errorf("%s: invalid response: %w", message, err)
+	}
+	if httpResp.StatusCode/100 != 2 {
+		return nil, fmt.Errorf("%s: %s: %s", message, httpResp.Status, resp.Error)
+	}
+	return resp, nil
+}
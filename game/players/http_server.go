@@ -0,0 +1,56 @@
+package players
+
+import (
+	"encoding/json"
+	"github.com/SachinMeier/modern-art.git/game"
+	"net/http"
+)
+
+// NewHTTPHandler serves a local Player over the protocol of HTTPPlayer, so that it can play in a Game
+// in another process. Decisions are cancelled when their request is.
+func NewHTTPHandler(player game.Player) http.Handler {
+	s := newRemoteServer(player)
+	mux := http.NewServeMux()
+	for _, message := range []string{
+		MessageHoldAuction,
+		MessageOfferDouble,
+		MessageSetPrice,
+		MessageBid,
+		MessageOpenBid,
+		MessageAuctionResult,
+		MessageAddArtPieces,
+		MessageMoveMoney,
+		MessageGameOver,
+	} {
+		mux.HandleFunc("/"+message, handleMessage(s, message))
+	}
+	return mux
+}
+
+// handleMessage decodes the RemoteRequest, answers the message and encodes the RemoteResponse
+func handleMessage(s *remoteServer, message string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			writeRemoteResponse(w, http.StatusMethodNotAllowed, &RemoteResponse{Error: "method must be POST"})
+			return
+		}
+		req := &RemoteRequest{}
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			writeRemoteResponse(w, http.StatusBadRequest, &RemoteResponse{Error: err.Error()})
+			return
+		}
+		resp, err := s.answer(r.Context(), message, req)
+		if err != nil {
+			writeRemoteResponse(w, http.StatusInternalServerError, &RemoteResponse{Error: err.Error()})
+			return
+		}
+		writeRemoteResponse(w, http.StatusOK, resp)
+	}
+}
+
+// writeRemoteResponse writes the RemoteResponse as JSON with the status
+func writeRemoteResponse(w http.ResponseWriter, status int, resp *RemoteResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(resp)
+}
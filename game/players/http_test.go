@@ -0,0 +1,143 @@
+package players_test
+
+import (
+	"context"
+	"github.com/SachinMeier/modern-art.git/game"
+	"github.com/SachinMeier/modern-art.git/game/players"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHTTPPlayerSuite(t *testing.T) {
+	suite.Run(t, new(HTTPPlayerTestSuite))
+}
+
+type HTTPPlayerTestSuite struct {
+	suite.Suite
+	testCtx    context.Context
+	cancelFunc context.CancelFunc
+}
+
+func (suite *HTTPPlayerTestSuite) SetupSuite() {}
+
+func (suite *HTTPPlayerTestSuite) SetupTest() {
+	suite.testCtx, suite.cancelFunc = context.WithCancel(context.Background())
+}
+
+func (suite *HTTPPlayerTestSuite) TearDownTest() {
+	suite.cancelFunc()
+}
+
+func (suite *HTTPPlayerTestSuite) TearDownSuite() {}
+
+func (suite *HTTPPlayerTestSuite) Test_RemoteGame() {
+	// 1. Test that a Player served over HTTP plays the same Game as the local Player
+	{
+		local, err := game.NewGame([]game.Player{
+			players.NewAlphaPlayer("alpha-1"),
+			players.NewAlphaPlayer("alpha-2"),
+			players.NewAlphaPlayer("alpha-3"),
+		}, game.WithSeed(11))
+		suite.Require().NoError(err)
+		localResult, err := local.Start()
+		suite.Require().NoError(err)
+
+		server := httptest.NewServer(players.NewHTTPHandler(players.NewAlphaPlayer("alpha-1")))
+		defer server.Close()
+		remote, err := game.NewGame([]game.Player{
+			players.NewHTTPPlayer("alpha-1", server.URL),
+			players.NewAlphaPlayer("alpha-2"),
+			players.NewAlphaPlayer("alpha-3"),
+		}, game.WithSeed(11), game.WithContext(suite.testCtx))
+		suite.Require().NoError(err)
+		remoteResult, err := remote.Start()
+		suite.Require().NoError(err)
+
+		suite.Equal(localResult.Scores(), remoteResult.Scores())
+	}
+
+	// 2. Test that a failing bot stops the Game with a PlayerError
+	{
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"error": "bot crashed"}`))
+		}))
+		defer server.Close()
+		ng, err := game.NewGame([]game.Player{
+			players.NewHTTPPlayer("remote-1", server.URL),
+			players.NewAlphaPlayer("alpha-2"),
+			players.NewAlphaPlayer("alpha-3"),
+		}, game.WithSeed(11), game.WithContext(suite.testCtx))
+		suite.Require().NoError(err)
+		_, err = ng.Start()
+
+		var playerErr *game.PlayerError
+		suite.Require().ErrorAs(err, &playerErr)
+		suite.Equal("remote-1", playerErr.Player)
+		suite.ErrorContains(err, "bot crashed")
+	}
+
+	// 3. Test that a bot that leaves out the ArtPiece for hold-auction misbehaves
+	{
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{}`))
+		}))
+		defer server.Close()
+		ng, err := game.NewGame([]game.Player{
+			players.NewHTTPPlayer("remote-1", server.URL),
+			players.NewAlphaPlayer("alpha-2"),
+			players.NewAlphaPlayer("alpha-3"),
+		}, game.WithSeed(11), game.WithContext(suite.testCtx))
+		suite.Require().NoError(err)
+		_, err = ng.Start()
+
+		var playerErr *game.PlayerError
+		suite.Require().ErrorAs(err, &playerErr)
+		suite.Equal("remote-1", playerErr.Player)
+		suite.ErrorIs(err, game.ErrInvalidAuction)
+	}
+}
+
+func (suite *HTTPPlayerTestSuite) Test_Handler() {
+	// 1. Test that the handler rejects requests that are not POSTs of a RemoteRequest
+	{
+		server := httptest.NewServer(players.NewHTTPHandler(players.NewAlphaPlayer("alpha-1")))
+		defer server.Close()
+
+		resp, err := http.Get(server.URL + players.PathBid)
+		suite.Require().NoError(err)
+		resp.Body.Close()
+		suite.Equal(http.StatusMethodNotAllowed, resp.StatusCode)
+
+		resp, err = http.Post(server.URL+players.PathBid, "application/json", nil)
+		suite.Require().NoError(err)
+		resp.Body.Close()
+		suite.Equal(http.StatusBadRequest, resp.StatusCode)
+	}
+
+	// 2. Test that a bid left out declines a set price and bids 0 otherwise
+	{
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{}`))
+		}))
+		defer server.Close()
+		remote := players.NewHTTPPlayer("remote-1", server.URL)
+		auctioneer := players.NewAlphaPlayer("alpha-2")
+		ng, err := game.NewGame([]game.Player{remote, auctioneer, players.NewAlphaPlayer("alpha-3")}, game.WithSeed(11))
+		suite.Require().NoError(err)
+		view := ng.View("remote-1")
+
+		setPrice := game.NewAuction(auctioneer, game.NewArtPieceWithAuctionType(game.Manuel, "manuel", game.AuctionTypeSetPrice), nil)
+		setPrice.WinningBid = game.NewBid(auctioneer, 0)
+		bid, err := remote.Bid(view, setPrice)
+		suite.NoError(err)
+		suite.Nil(bid)
+
+		oneShot := game.NewAuction(auctioneer, game.NewArtPieceWithAuctionType(game.Manuel, "manuel", game.AuctionTypeOneShot), nil)
+		bid, err = remote.Bid(view, oneShot)
+		suite.NoError(err)
+		suite.Equal(0, bid.Value)
+	}
+}
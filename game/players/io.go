@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/SachinMeier/modern-art.git/game"
 	"strconv"
+	"strings"
 )
 
 /*
@@ -18,17 +19,11 @@ checking the sanity of other players and for having fun.
 
 // IOPlayer is a manually controlled player.
 type IOPlayer struct {
-	name       string
-	hand       []*game.ArtPiece
-	collection []*game.ArtPiece
-	Money      int
+	name  string
+	Money int
 
-	// TODO: possibly make this a map[game.Player][]*game.ArtPiece
-	otherCollections map[string][]*game.ArtPiece
-
-	currentPhase *game.Phase
-	phases       []*game.Phase
-	phasePayouts []map[game.Artist]int
+	// view is the GameView of the latest decision, used to print the Game on request
+	view *game.GameView
 }
 
 // Ensures that IOPlayer implements Player interface at compile time
@@ -37,15 +32,8 @@ var _ game.Player = &IOPlayer{}
 // NewIOPlayer creates a new IOPlayer
 func NewIOPlayer(name string) *IOPlayer {
 	return &IOPlayer{
-		name:       name,
-		hand:       make([]*game.ArtPiece, 0),
-		collection: make([]*game.ArtPiece, 0),
-		Money:      0,
-
-		otherCollections: make(map[string][]*game.ArtPiece),
-		currentPhase:     game.NewPhase(),
-		phases:           make([]*game.Phase, 0),
-		phasePayouts:     make([]map[game.Artist]int, 0),
+		name:  name,
+		Money: 0,
 	}
 }
 
@@ -60,14 +48,16 @@ func (p *IOPlayer) SetName(name string) {
 }
 
 // HoldAuction asks the Player to pick an ArtPiece to auction
-func (p *IOPlayer) HoldAuction() (*game.Auction, error) {
+func (p *IOPlayer) HoldAuction(view *game.GameView) (*game.Auction, error) {
+	p.view = view
+	hand := view.Hand()
 	fmt.Printf("Your turn to auction.\n")
 	p.printHand()
 	fmt.Printf("enter a number to auction that card\n")
 
 	choice := p.handleInput()
 	for {
-		if choice < 0 || choice >= len(p.hand) {
+		if choice < 0 || choice >= len(hand) {
 			fmt.Printf("invalid choice: %d\n", choice)
 			// re-request input
 			choice = p.handleInput()
@@ -76,21 +66,87 @@ func (p *IOPlayer) HoldAuction() (*game.Auction, error) {
 		break
 	}
 
-	artPiece := p.hand[choice]
-	// remove it from the hand
-	p.hand = append(p.hand[:choice], p.hand[choice+1:]...)
-	return game.NewAuction(p, artPiece, game.NewBid(p, 0)), nil
+	artPiece := hand[choice]
+	// remove it from the hand so it cannot be picked again as the second card
+	hand = append(hand[:choice], hand[choice+1:]...)
+	auction := game.NewAuction(p, artPiece, game.NewBid(p, 0))
+	if artPiece.IsDouble() {
+		fmt.Printf("You played a double. You can add a second card by the same artist.\n")
+		auction.SecondArtPiece = p.chooseSecondArtPiece(hand, artPiece.Artist)
+	}
+	return auction, nil
+}
+
+// OfferDouble asks the Player whether to add a second card to another Player's double Auction
+func (p *IOPlayer) OfferDouble(view *game.GameView, auction *game.Auction) (*game.ArtPiece, error) {
+	p.view = view
+	fmt.Printf("%s played a double: %s\n", auction.Auctioneer.Name(), strArtPiece(auction.ArtPiece))
+	fmt.Printf("You can add a second card by the same artist and become the auctioneer.\n")
+	return p.chooseSecondArtPiece(view.Hand(), auction.ArtPiece.Artist), nil
+}
+
+// chooseSecondArtPiece asks the Player to pick a non-double card by the artist from the hand.
+// Returns nil if they have none or decline.
+func (p *IOPlayer) chooseSecondArtPiece(hand []*game.ArtPiece, artist game.Artist) *game.ArtPiece {
+	choices := make([]int, 0)
+	for i, artPiece := range hand {
+		if artPiece.Artist == artist && !artPiece.IsDouble() {
+			choices = append(choices, i)
+		}
+	}
+	if len(choices) == 0 {
+		fmt.Printf("You have no card to add.\n")
+		return nil
+	}
+
+	fmt.Printf("Enter the number of the card to add or -1 to decline:\n")
+	for _, i := range choices {
+		fmt.Printf("  %d: %s\n", i, strArtPiece(hand[i]))
+	}
+	for {
+		choice := p.handleInput()
+		if choice == -1 {
+			return nil
+		}
+		for _, i := range choices {
+			if choice == i {
+				return hand[choice]
+			}
+		}
+		fmt.Printf("invalid choice: %d\n", choice)
+	}
+}
+
+// SetPrice requests the Player to set the price of their Auction
+func (p *IOPlayer) SetPrice(view *game.GameView, auction *game.Auction) (int, error) {
+	p.view = view
+	fmt.Printf("Set the price for your auction:\n")
+	printAuction(auction)
+	fmt.Printf("You have %d money. If no one accepts the price, you must pay it to the bank. Enter your price:\n", view.Money())
+
+	price := p.handleInput()
+	for {
+		if price < 0 || price > view.Money() {
+			fmt.Printf("invalid price: %d\n", price)
+			// restart
+			price = p.handleInput()
+			continue
+		}
+		break
+	}
+	return price, nil
 }
 
 // Bid requests the Player to place a Bid on an Auction
-func (p *IOPlayer) Bid(auction *game.Auction) (*game.Bid, error) {
+func (p *IOPlayer) Bid(view *game.GameView, auction *game.Auction) (*game.Bid, error) {
+	p.view = view
 	fmt.Printf("The following card is up for auction:\n")
 	printAuction(auction)
-	fmt.Printf("You have %d money. Enter your bid:\n", p.Money)
+	fmt.Printf("You have %d money. Enter your bid:\n", view.Money())
 
 	bid := p.handleInput()
 	for {
-		if bid < 0 || bid > p.Money {
+		if bid < 0 || bid > view.Money() {
 			fmt.Printf("invalid bid: %d\n", bid)
 			// restart
 			bid = p.handleInput()
@@ -105,84 +161,55 @@ func (p *IOPlayer) Bid(auction *game.Auction) (*game.Bid, error) {
 	}, nil
 }
 
-// OpenBid requests the Player to place Bid's on an Auction of type AuctionTypeOpen
-func (p *IOPlayer) OpenBid(auction *game.Auction, recv <-chan *game.Bid, send chan<- *game.Bid) {
-	fmt.Printf("The following card is up for live auction:\n")
+// OpenBid asks the Player to raise, pass or withdraw in an Auction of type AuctionTypeOpen.
+// The auctioneer can also close the Auction.
+func (p *IOPlayer) OpenBid(view *game.GameView, auction *game.Auction, going game.Going) (*game.OpenBid, error) {
+	p.view = view
+	fmt.Printf("The following card is up for live auction (%s):\n", going)
 	printAuction(auction)
-	fmt.Printf("You have %d money. You can keep entering new bids until the auction is over or bid 0 to quit the auction.\n", p.Money)
-	// print all new bids
-	auctionOver := make(chan bool, 1)
-	go func() {
-		for {
-			bid, more := <-recv
-			if !more {
-				auctionOver <- true
-				return
-			}
-			fmt.Printf("New best bid: (%s) %d\n", bid.Bidder.Name(), bid.Value)
-		}
-	}()
-
-	// listen for input
-	userBids := make(chan int, 1)
-
-	go func() {
-		for {
-			choice := p.handleInput()
-			if choice == 0 {
-				fmt.Printf("You quit the auction. Waiting for auction to end.\n")
-				close(send)
-				return
-			}
-			userBids <- choice
-		}
-	}()
+	fmt.Printf("You have %d money. Enter a bid to raise, 0 to pass or -1 to withdraw from the auction.\n", view.Money())
+	isAuctioneer := auction.Auctioneer.Name() == p.name
+	if isAuctioneer {
+		fmt.Printf("You are the auctioneer. Enter -2 to close the auction.\n")
+	}
+	standing := auction.WinningBid.Bidder.Name() == p.name
+	if standing {
+		fmt.Printf("You hold the standing bid and cannot raise it.\n")
+	}
 
 	for {
-		select {
-		case <-auctionOver:
-			fmt.Printf("Auction is over.\n")
-			return
-		case choice := <-userBids:
-			send <- &game.Bid{
-				Bidder: p,
-				Value:  choice,
-			}
+		choice := p.handleInput()
+		switch {
+		case choice == 0:
+			return game.NewPass(), nil
+		case choice == -1:
+			return game.NewWithdrawal(), nil
+		case choice == -2 && isAuctioneer:
+			return game.NewClose(), nil
+		case !standing && choice > auction.WinningBid.Value && choice <= view.Money():
+			return game.NewRaise(game.NewBid(p, choice)), nil
 		}
+		fmt.Printf("invalid choice: %d\n", choice)
 	}
-
 }
 
 // HandleAuctionResult informs the Player of the result of a game.Auction
 func (p *IOPlayer) HandleAuctionResult(auction *game.Auction) {
-	p.currentPhase.AddAuction(auction)
-	if p.currentPhase.IsOver() {
-		p.phases = append(p.phases, p.currentPhase)
-		p.phasePayouts = append(p.phasePayouts, game.CumulativePayouts(p.phases))
-		p.currentPhase = game.NewPhase()
-	}
-
 	auctionWinner := auction.WinningBid.Bidder.Name()
 
 	if auctionWinner == p.name {
 		fmt.Printf("You won the auction!\n")
-		fmt.Printf("You paid %d for %s\n", auction.WinningBid.Value, strArtPiece(auction.ArtPiece))
-		// add the ArtPiece to their collection
-		p.collection = append(p.collection, auction.ArtPiece)
+		fmt.Printf("You paid %d for %s\n", auction.WinningBid.Value, strArtPieces(auction.ArtPieces()))
 	} else {
-		if _, ok := p.otherCollections[auctionWinner]; !ok {
-			p.otherCollections[auctionWinner] = make([]*game.ArtPiece, 0)
-		}
-		p.otherCollections[auctionWinner] = append(p.otherCollections[auctionWinner], auction.ArtPiece)
-		fmt.Printf("%s won the auction for %s\n", auctionWinner, strArtPiece(auction.ArtPiece))
+		fmt.Printf("%s won the auction for %s\n", auctionWinner, strArtPieces(auction.ArtPieces()))
 	}
 }
 
-// AddArtPieces adds ArtPiece's to the Player's hand
+// AddArtPieces shows the Player the ArtPiece's they were dealt
 func (p *IOPlayer) AddArtPieces(pieces []*game.ArtPiece) {
-	p.hand = append(p.hand, pieces...)
 	fmt.Printf("You've been dealt new cards:\n")
-	p.printHand()
+	printArtPieces(pieces)
+	printSeparator()
 }
 
 // MoveMoney gives the Player money. Currently only used for payouts.
@@ -227,30 +254,38 @@ func (p *IOPlayer) handleInput() int {
 	}
 }
 
-// printers
+// printers show the GameView of the latest decision. Nothing is shown before the first one.
 
 func (p *IOPlayer) printHand() {
 	fmt.Printf("Your hand:\n")
-	for i, artPiece := range p.hand {
-		fmt.Printf("  %d: %s\n", i, strArtPiece(artPiece))
+	if p.view != nil {
+		printArtPieces(p.view.Hand())
 	}
 	printSeparator()
 }
 
 func (p *IOPlayer) printCollection() {
 	fmt.Printf("Your collection:\n")
-	for _, artPiece := range p.collection {
-		fmt.Printf("  %s (%s)\n", artPiece.Artist, artPiece.Name)
+	if p.view != nil {
+		for _, artPiece := range p.view.Collection(p.name) {
+			fmt.Printf("  %s\n", strArtPiece(artPiece))
+		}
 	}
 	printSeparator()
 }
 
 func (p *IOPlayer) printOtherCollections() {
 	fmt.Printf("Other collections:\n")
-	for other, artPieces := range p.otherCollections {
+	if p.view == nil {
+		return
+	}
+	for _, other := range p.view.TurnOrder() {
+		if other == p.name {
+			continue
+		}
 		fmt.Printf("Player %s\n", other)
-		for _, artPiece := range artPieces {
-			fmt.Printf("  %s (%s)\n", artPiece.Artist, artPiece.Name)
+		for _, artPiece := range p.view.Collection(other) {
+			fmt.Printf("  %s\n", strArtPiece(artPiece))
 		}
 		printSeparator()
 	}
@@ -258,16 +293,26 @@ func (p *IOPlayer) printOtherCollections() {
 
 func (p *IOPlayer) printPhasePayouts() {
 	fmt.Printf("Phase payouts:\n")
+	var phases []*game.Phase
+	if p.view != nil {
+		phases = p.view.PastPhases()
+	}
 	for _, artist := range game.AllArtists() {
 		fmt.Printf("  %s:", artist)
-		for _, phasePayout := range p.phasePayouts {
-			fmt.Printf("  %d  ", phasePayout[artist])
+		for i := range phases {
+			fmt.Printf("  %d  ", game.CumulativePayouts(phases[:i+1])[artist])
 		}
 		fmt.Println()
 	}
 	printSeparator()
 }
 
+func printArtPieces(artPieces []*game.ArtPiece) {
+	for i, artPiece := range artPieces {
+		fmt.Printf("  %d: %s\n", i, strArtPiece(artPiece))
+	}
+}
+
 func printSeparator() {
 	fmt.Printf("---\n")
 }
@@ -276,9 +321,20 @@ func strArtPiece(artPiece *game.ArtPiece) string {
 	return fmt.Sprintf("%s (%s)", artPiece.Artist, artPiece.Name)
 }
 
+func strArtPieces(artPieces []*game.ArtPiece) string {
+	strs := make([]string, len(artPieces))
+	for i, artPiece := range artPieces {
+		strs[i] = strArtPiece(artPiece)
+	}
+	return strings.Join(strs, " and ")
+}
+
 func printAuction(auction *game.Auction) {
 	fmt.Printf("%s Auction:\n", auction.Type)
 	fmt.Printf("  ArtPiece: %s\n", strArtPiece(auction.ArtPiece))
+	if auction.SecondArtPiece != nil {
+		fmt.Printf("  SecondArtPiece: %s\n", strArtPiece(auction.SecondArtPiece))
+	}
 	fmt.Printf("  CurrentBid: %d\n", auction.WinningBid.Value)
 	printSeparator()
 }
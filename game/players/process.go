@@ -0,0 +1,336 @@
+package players
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/SachinMeier/modern-art.git/game"
+	"io"
+	"os/exec"
+	"time"
+)
+
+/*
+ProcessPlayer runs a bot as a child process and sends it the messages of the remote protocol as JSON lines on
+its stdin. The bot answers each message with a JSON line on its stdout carrying the message's id. Like UCI for
+chess engines, a Game starts with a hello, in which the bot picks a protocol version, and a new-game, and the
+bot is told to quit when the Player is closed. ServeProcess runs a local Player as such a bot.
+*/
+
+// ProtocolVersion is the version of the process protocol spoken by ProcessPlayer and ServeProcess
+const ProtocolVersion = 1
+
+// Messages of the process protocol, besides those of the remote protocol
+const (
+	MessageHello   = "hello"
+	MessageNewGame = "new-game"
+	MessageQuit    = "quit"
+)
+
+// defaultProcessTimeout is the time a bot has to answer a message by default
+const defaultProcessTimeout = 10 * time.Second
+
+// ProcessMessage is a line sent to a bot
+type ProcessMessage struct {
+	// ID is repeated in the bot's reply
+	ID   int    `json:"id"`
+	Type string `json:"type"`
+	// Versions are the protocol versions the Game speaks. Set for hello.
+	Versions []int `json:"versions,omitempty"`
+	// Player is the bot's name in the Game. Set for new-game.
+	Player string `json:"player,omitempty"`
+	*RemoteRequest
+}
+
+// ProcessReply is a line sent back by a bot
+type ProcessReply struct {
+	// ID is the ID of the message answered
+	ID int `json:"id"`
+	// Version is the protocol version the bot picked. Set in reply to hello.
+	Version int `json:"version,omitempty"`
+	RemoteResponse
+}
+
+// processLine is a reply read from the bot, or the error of reading it
+type processLine struct {
+	reply *ProcessReply
+	err   error
+}
+
+// ProcessPlayer is a Player whose decisions are made by a bot in a child process
+type ProcessPlayer struct {
+	*RemotePlayer
+	command string
+	args    []string
+	env     []string
+	timeout time.Duration
+	stderr  io.Writer
+
+	started  bool
+	startErr error
+	cmd      *exec.Cmd
+	stdin    io.WriteCloser
+	replies  <-chan processLine
+	nextID   int
+}
+
+// Ensures that ProcessPlayer implements ContextPlayer and ResultPlayer interfaces at compile time
+var (
+	_ game.ContextPlayer = &ProcessPlayer{}
+	_ game.ResultPlayer  = &ProcessPlayer{}
+)
+
+// NewProcessPlayer creates a new ProcessPlayer for the bot run by the command. The bot is started by Start,
+// or by the first message of the Game, so that the Player can be created by a tournament.Factory.
+func NewProcessPlayer(name string, command string, args ...string) *ProcessPlayer {
+	p := &ProcessPlayer{
+		command: command,
+		args:    args,
+		timeout: defaultProcessTimeout,
+	}
+	p.RemotePlayer = NewRemotePlayer(name, p)
+	return p
+}
+
+// SetTimeout sets the time the bot has to answer each message
+func (p *ProcessPlayer) SetTimeout(timeout time.Duration) {
+	p.timeout = timeout
+}
+
+// SetEnv sets the environment of the bot, which inherits the Game's by default
+func (p *ProcessPlayer) SetEnv(env []string) {
+	p.env = env
+}
+
+// SetStderr sets where the bot's stderr goes, which is discarded by default
+func (p *ProcessPlayer) SetStderr(stderr io.Writer) {
+	p.stderr = stderr
+}
+
+// Start starts the bot and shakes hands with it. It returns the same error if called again.
+func (p *ProcessPlayer) Start() error {
+	if !p.started {
+		p.started = true
+		p.startErr = p.start()
+	}
+	return p.startErr
+}
+
+func (p *ProcessPlayer) start() error {
+	cmd := exec.Command(p.command, p.args...)
+	cmd.Env = p.env
+	cmd.Stderr = p.stderr
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	replies := make(chan processLine)
+	go readReplies(stdout, replies)
+	p.cmd, p.stdin, p.replies = cmd, stdin, replies
+
+	reply, err := p.exchange(context.Background(), &ProcessMessage{Type: MessageHello, Versions: []int{ProtocolVersion}})
+	if err == nil && reply.Version != ProtocolVersion {
+		err = fmt.Errorf("%w: bot picked %d", ErrProtocolVersion, reply.Version)
+	}
+	if err == nil {
+		_, err = p.exchange(context.Background(), &ProcessMessage{Type: MessageNewGame, Player: p.name})
+	}
+	if err != nil {
+		p.kill()
+		return fmt.Errorf("handshake: %w", err)
+	}
+	return nil
+}
+
+// Close tells the bot to quit and waits for it to exit. A bot that does not exit in time is killed.
+func (p *ProcessPlayer) Close() error {
+	if p.cmd == nil {
+		return nil
+	}
+	line, err := json.Marshal(&ProcessMessage{ID: p.nextID + 1, Type: MessageQuit})
+	if err == nil {
+		_, _ = p.stdin.Write(append(line, '\n'))
+	}
+	_ = p.stdin.Close()
+
+	// the bot has exited once it closes its stdout
+	timer := time.NewTimer(p.timeout)
+	defer timer.Stop()
+	for {
+		select {
+		case _, ok := <-p.replies:
+			if ok {
+				continue
+			}
+			err := p.cmd.Wait()
+			p.cmd = nil
+			return err
+		case <-timer.C:
+			p.kill()
+			return fmt.Errorf("quit: %w", ErrBotTimeout)
+		}
+	}
+}
+
+// kill kills the bot and waits for it to exit
+func (p *ProcessPlayer) kill() {
+	_ = p.cmd.Process.Kill()
+	for range p.replies {
+	}
+	_ = p.cmd.Wait()
+	p.cmd = nil
+}
+
+// Send sends the message to the bot, starting it first if needed
+func (p *ProcessPlayer) Send(ctx context.Context, message string, req *RemoteRequest) (*RemoteResponse, error) {
+	if err := p.Start(); err != nil {
+		return nil, err
+	}
+	if p.cmd == nil {
+		return nil, ErrBotExited
+	}
+	reply, err := p.exchange(ctx, &ProcessMessage{Type: message, RemoteRequest: req})
+	if err != nil {
+		return nil, err
+	}
+	return &reply.RemoteResponse, nil
+}
+
+// exchange writes the message and waits for the bot's reply to it. Replies to earlier messages,
+// which came too late, are skipped.
+func (p *ProcessPlayer) exchange(ctx context.Context, msg *ProcessMessage) (*ProcessReply, error) {
+	p.nextID++
+	msg.ID = p.nextID
+	line, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := p.stdin.Write(append(line, '\n')); err != nil {
+		// the bot closed its stdin, which it only does when it exits
+		return nil, fmt.Errorf("%s: %w: %s", msg.Type, ErrBotExited, err)
+	}
+
+	timer := time.NewTimer(p.timeout)
+	defer timer.Stop()
+	for {
+		select {
+		case line, ok := <-p.replies:
+			if !ok {
+				return nil, fmt.Errorf("%s: %w", msg.Type, ErrBotExited)
+			}
+			if line.err != nil {
+				return nil, fmt.Errorf("%s: %w", msg.Type, line.err)
+			}
+			if line.reply.ID != msg.ID {
+				continue
+			}
+			if line.reply.Error != "" {
+				return nil, fmt.Errorf("%s: %s", msg.Type, line.reply.Error)
+			}
+			return line.reply, nil
+		case <-timer.C:
+			return nil, fmt.Errorf("%s: %w", msg.Type, ErrBotTimeout)
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// readReplies sends every line of the bot's stdout as a reply until it is closed
+func readReplies(stdout io.Reader, replies chan<- processLine) {
+	defer close(replies)
+	reader := bufio.NewReader(stdout)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if len(bytes.TrimSpace(line)) > 0 {
+			reply := &ProcessReply{}
+			if jsonErr := json.Unmarshal(line, reply); jsonErr != nil {
+				replies <- processLine{err: fmt.Errorf("invalid reply %q: %w", bytes.TrimSpace(line), jsonErr)}
+			} else {
+				replies <- processLine{reply: reply}
+			}
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// ServeProcess runs local Players as a bot of the process protocol, reading messages from in and writing replies
+// to out until it is told to quit or in is closed. Each new-game creates a new Player with the name it is given.
+// Decisions are answered one at a time.
+func ServeProcess(newPlayer func(name string) game.Player, in io.Reader, out io.Writer) error {
+	var s *remoteServer
+	encoder := json.NewEncoder(out)
+	reader := bufio.NewReader(in)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if len(bytes.TrimSpace(line)) > 0 {
+			msg := &ProcessMessage{}
+			if jsonErr := json.Unmarshal(line, msg); jsonErr != nil {
+				return fmt.Errorf("invalid message %q: %w", bytes.TrimSpace(line), jsonErr)
+			}
+			reply := &ProcessReply{ID: msg.ID}
+			switch msg.Type {
+			case MessageQuit:
+				return nil
+			case MessageHello:
+				reply.Version = ProtocolVersion
+				if !containsVersion(msg.Versions, ProtocolVersion) {
+					reply.Version, reply.Error = 0, ErrProtocolVersion.Error()
+				}
+			case MessageNewGame:
+				s = newRemoteServer(newPlayer(msg.Player))
+			default:
+				reply.RemoteResponse = answerMessage(s, msg)
+			}
+			if err := encoder.Encode(reply); err != nil {
+				return err
+			}
+			if reply.Error != "" && msg.Type == MessageHello {
+				return ErrProtocolVersion
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// answerMessage answers a message of the remote protocol with the Player of the Game
+func answerMessage(s *remoteServer, msg *ProcessMessage) RemoteResponse {
+	if s == nil {
+		return RemoteResponse{Error: fmt.Sprintf("%s before %s", msg.Type, MessageNewGame)}
+	}
+	req := msg.RemoteRequest
+	if req == nil {
+		req = &RemoteRequest{}
+	}
+	resp, err := s.answer(context.Background(), msg.Type, req)
+	if err != nil {
+		return RemoteResponse{Error: err.Error()}
+	}
+	return *resp
+}
+
+// containsVersion returns true if the protocol version is one of the versions
+func containsVersion(versions []int, version int) bool {
+	for _, v := range versions {
+		if v == version {
+			return true
+		}
+	}
+	return false
+}
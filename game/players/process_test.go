@@ -0,0 +1,121 @@
+package players_test
+
+import (
+	"context"
+	"github.com/SachinMeier/modern-art.git/game"
+	"github.com/SachinMeier/modern-art.git/game/players"
+	"github.com/stretchr/testify/suite"
+	"os"
+	"testing"
+	"time"
+)
+
+// botEnv makes the test binary run as a bot that serves AlphaPlayers over the process protocol
+const botEnv = "MODERN_ART_TEST_BOT"
+
+func TestMain(m *testing.M) {
+	if os.Getenv(botEnv) != "" {
+		newPlayer := func(name string) game.Player { return players.NewAlphaPlayer(name) }
+		if err := players.ServeProcess(newPlayer, os.Stdin, os.Stdout); err != nil {
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+func TestProcessPlayerSuite(t *testing.T) {
+	suite.Run(t, new(ProcessPlayerTestSuite))
+}
+
+type ProcessPlayerTestSuite struct {
+	suite.Suite
+	testCtx    context.Context
+	cancelFunc context.CancelFunc
+}
+
+func (suite *ProcessPlayerTestSuite) SetupSuite() {}
+
+func (suite *ProcessPlayerTestSuite) SetupTest() {
+	suite.testCtx, suite.cancelFunc = context.WithCancel(context.Background())
+}
+
+func (suite *ProcessPlayerTestSuite) TearDownTest() {
+	suite.cancelFunc()
+}
+
+func (suite *ProcessPlayerTestSuite) TearDownSuite() {}
+
+func (suite *ProcessPlayerTestSuite) Test_ProcessGame() {
+	// 1. Test that a bot in a child process plays the same Game as the local Player and quits when closed
+	{
+		local, err := game.NewGame([]game.Player{
+			players.NewAlphaPlayer("alpha-1"),
+			players.NewAlphaPlayer("alpha-2"),
+			players.NewAlphaPlayer("alpha-3"),
+		}, game.WithSeed(11))
+		suite.Require().NoError(err)
+		localResult, err := local.Start()
+		suite.Require().NoError(err)
+
+		bot := suite.newBot("alpha-1")
+		remote, err := game.NewGame([]game.Player{
+			bot,
+			players.NewAlphaPlayer("alpha-2"),
+			players.NewAlphaPlayer("alpha-3"),
+		}, game.WithSeed(11), game.WithContext(suite.testCtx))
+		suite.Require().NoError(err)
+		remoteResult, err := remote.Start()
+		suite.Require().NoError(err)
+
+		suite.Equal(localResult.Scores(), remoteResult.Scores())
+		suite.NoError(bot.Close())
+		suite.NoError(bot.Close())
+	}
+}
+
+func (suite *ProcessPlayerTestSuite) Test_Handshake() {
+	// 1. Test that the handshake fails if the bot picks an unsupported protocol version
+	{
+		bot := players.NewProcessPlayer("bot", "sh", "-c", `read line; echo '{"id": 1, "version": 99}'; cat > /dev/null`)
+		bot.SetTimeout(time.Second)
+		suite.ErrorIs(bot.Start(), players.ErrProtocolVersion)
+		suite.ErrorIs(bot.Start(), players.ErrProtocolVersion)
+		suite.NoError(bot.Close())
+	}
+
+	// 2. Test that a bot that never answers times out
+	{
+		bot := players.NewProcessPlayer("bot", "sh", "-c", `cat > /dev/null`)
+		bot.SetTimeout(100 * time.Millisecond)
+		suite.ErrorIs(bot.Start(), players.ErrBotTimeout)
+	}
+
+	// 3. Test that a bot that exits fails its decisions, which stops the Game with a PlayerError
+	{
+		bot := players.NewProcessPlayer("bot", "sh", "-c", `exit 0`)
+		ng, err := game.NewGame([]game.Player{
+			bot,
+			players.NewAlphaPlayer("alpha-2"),
+			players.NewAlphaPlayer("alpha-3"),
+		}, game.WithSeed(11))
+		suite.Require().NoError(err)
+		_, err = ng.Start()
+
+		var playerErr *game.PlayerError
+		suite.Require().ErrorAs(err, &playerErr)
+		suite.Equal("bot", playerErr.Player)
+		suite.ErrorIs(err, players.ErrBotExited)
+	}
+}
+
+// helpers
+
+// newBot runs the test binary as a bot serving an AlphaPlayer
+func (suite *ProcessPlayerTestSuite) newBot(name string) *players.ProcessPlayer {
+	executable, err := os.Executable()
+	suite.Require().NoError(err)
+	bot := players.NewProcessPlayer(name, executable)
+	bot.SetEnv(append(os.Environ(), botEnv+"=1"))
+	return bot
+}
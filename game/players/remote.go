@@ -0,0 +1,244 @@
+package players
+
+import (
+	"context"
+	"github.com/SachinMeier/modern-art.git/game"
+	"time"
+)
+
+/*
+Remote Players forward every decision and notification of the Game to a bot outside the process, so bots can
+be written in any language. Each call is a message with a RemoteRequest, which the bot answers with a
+RemoteResponse. HTTPPlayer sends the messages over HTTP and ProcessPlayer over the stdin and stdout of a
+child process. Other transports implement RemoteTransport and play with NewRemotePlayer. See the README for
+the schema.
+*/
+
+// Messages of the remote protocol
+const (
+	MessageHoldAuction   = "hold-auction"
+	MessageOfferDouble   = "offer-double"
+	MessageSetPrice      = "set-price"
+	MessageBid           = "bid"
+	MessageOpenBid       = "open-bid"
+	MessageAuctionResult = "auction-result"
+	MessageAddArtPieces  = "add-art-pieces"
+	MessageMoveMoney     = "move-money"
+	MessageGameOver      = "game-over"
+)
+
+// notificationTimeout is the time a bot has to acknowledge a notification
+const notificationTimeout = 10 * time.Second
+
+// RemoteRequest is the body of every message to a bot. Each message only sets the fields it needs.
+type RemoteRequest struct {
+	// View is set for decisions
+	View *game.ViewState `json:"view,omitempty"`
+	// Auction is set for every decision but hold-auction, and for auction-result
+	Auction *game.AuctionSnapshot `json:"auction,omitempty"`
+	// Going is the auctioneer's call for open-bid: 0 while bidding, 1 going once and 2 going twice
+	Going game.Going `json:"going"`
+	// ArtPieces are set for add-art-pieces
+	ArtPieces []*game.ArtPiece `json:"art_pieces,omitempty"`
+	// Amount is set for move-money
+	Amount int `json:"amount"`
+	// Result is set for game-over
+	Result *game.GameResult `json:"result,omitempty"`
+}
+
+// RemoteResponse is the body of every answer of a bot. Each message only reads the fields it needs.
+type RemoteResponse struct {
+	// ArtPiece is the ArtPiece to auction for hold-auction, or to add for offer-double. Leave it out to decline
+	// offer-double. hold-auction requires it: leaving it out is handled as misbehavior under the MisbehaviorPolicy.
+	ArtPiece *game.ArtPiece `json:"art_piece,omitempty"`
+	// SecondArtPiece may be attached to a double ArtPiece for hold-auction
+	SecondArtPiece *game.ArtPiece `json:"second_art_piece,omitempty"`
+	// Price is the price for set-price
+	Price int `json:"price"`
+	// Bid is the bid for bid, or the new standing bid of a raise for open-bid. Leaving it out of bid declines
+	// a set price and otherwise bids 0.
+	Bid *int `json:"bid,omitempty"`
+	// Move is raise, pass, withdraw or close for open-bid
+	Move game.OpenBidType `json:"move,omitempty"`
+	// Error is set by the bot when it fails
+	Error string `json:"error,omitempty"`
+}
+
+// RemoteTransport delivers the messages of a RemotePlayer to its bot
+type RemoteTransport interface {
+	// Send sends the message with the RemoteRequest and returns the bot's RemoteResponse
+	Send(ctx context.Context, message string, req *RemoteRequest) (*RemoteResponse, error)
+}
+
+// RemotePlayer is a Player whose decisions are made by a bot reached through a RemoteTransport
+type RemotePlayer struct {
+	name      string
+	transport RemoteTransport
+
+	// err is the first error of a notification, which the Player interface cannot return.
+	// It is returned by the next decision instead.
+	err error
+}
+
+// Ensures that RemotePlayer implements ContextPlayer and ResultPlayer interfaces at compile time
+var (
+	_ game.ContextPlayer = &RemotePlayer{}
+	_ game.ResultPlayer  = &RemotePlayer{}
+)
+
+// NewRemotePlayer creates a new RemotePlayer that sends its messages over the transport
+func NewRemotePlayer(name string, transport RemoteTransport) *RemotePlayer {
+	return &RemotePlayer{
+		name:      name,
+		transport: transport,
+	}
+}
+
+// Name returns the Player's name
+func (p *RemotePlayer) Name() string {
+	return p.name
+}
+
+// HoldAuction asks the bot for an ArtPiece to auction
+func (p *RemotePlayer) HoldAuction(view *game.GameView) (*game.Auction, error) {
+	return p.HoldAuctionContext(context.Background(), view)
+}
+
+// HoldAuctionContext asks the bot for an ArtPiece to auction
+func (p *RemotePlayer) HoldAuctionContext(ctx context.Context, view *game.GameView) (*game.Auction, error) {
+	resp, err := p.decide(ctx, MessageHoldAuction, &RemoteRequest{View: view.State()})
+	if err != nil || resp.ArtPiece == nil {
+		return nil, err
+	}
+	auction := game.NewAuction(p, artPieceInHand(view, resp.ArtPiece), nil)
+	if resp.SecondArtPiece != nil {
+		auction.SecondArtPiece = artPieceInHand(view, resp.SecondArtPiece)
+	}
+	return auction, nil
+}
+
+// OfferDouble asks the bot whether to add an ArtPiece to a double Auction
+func (p *RemotePlayer) OfferDouble(view *game.GameView, auction *game.Auction) (*game.ArtPiece, error) {
+	return p.OfferDoubleContext(context.Background(), view, auction)
+}
+
+// OfferDoubleContext asks the bot whether to add an ArtPiece to a double Auction
+func (p *RemotePlayer) OfferDoubleContext(ctx context.Context, view *game.GameView, auction *game.Auction) (*game.ArtPiece, error) {
+	resp, err := p.decide(ctx, MessageOfferDouble, &RemoteRequest{View: view.State(), Auction: game.NewAuctionSnapshot(auction)})
+	if err != nil || resp.ArtPiece == nil {
+		return nil, err
+	}
+	return artPieceInHand(view, resp.ArtPiece), nil
+}
+
+// SetPrice asks the bot for the price of their Auction
+func (p *RemotePlayer) SetPrice(view *game.GameView, auction *game.Auction) (int, error) {
+	return p.SetPriceContext(context.Background(), view, auction)
+}
+
+// SetPriceContext asks the bot for the price of their Auction
+func (p *RemotePlayer) SetPriceContext(ctx context.Context, view *game.GameView, auction *game.Auction) (int, error) {
+	resp, err := p.decide(ctx, MessageSetPrice, &RemoteRequest{View: view.State(), Auction: game.NewAuctionSnapshot(auction)})
+	if err != nil {
+		return 0, err
+	}
+	return resp.Price, nil
+}
+
+// Bid asks the bot for a Bid
+func (p *RemotePlayer) Bid(view *game.GameView, auction *game.Auction) (*game.Bid, error) {
+	return p.BidContext(context.Background(), view, auction)
+}
+
+// BidContext asks the bot for a Bid
+func (p *RemotePlayer) BidContext(ctx context.Context, view *game.GameView, auction *game.Auction) (*game.Bid, error) {
+	resp, err := p.decide(ctx, MessageBid, &RemoteRequest{View: view.State(), Auction: game.NewAuctionSnapshot(auction)})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Bid == nil {
+		if auction.Type == game.AuctionTypeSetPrice {
+			return nil, nil
+		}
+		return game.NewBid(p, 0), nil
+	}
+	return game.NewBid(p, *resp.Bid), nil
+}
+
+// OpenBid asks the bot for their move in an open Auction
+func (p *RemotePlayer) OpenBid(view *game.GameView, auction *game.Auction, going game.Going) (*game.OpenBid, error) {
+	return p.OpenBidContext(context.Background(), view, auction, going)
+}
+
+// OpenBidContext asks the bot for their move in an open Auction
+func (p *RemotePlayer) OpenBidContext(ctx context.Context, view *game.GameView, auction *game.Auction, going game.Going) (*game.OpenBid, error) {
+	resp, err := p.decide(ctx, MessageOpenBid, &RemoteRequest{View: view.State(), Auction: game.NewAuctionSnapshot(auction), Going: going})
+	if err != nil {
+		return nil, err
+	}
+	switch resp.Move {
+	case game.OpenBidRaise:
+		if resp.Bid == nil {
+			return nil, game.ErrInvalidOpenBid
+		}
+		return game.NewRaise(game.NewBid(p, *resp.Bid)), nil
+	case game.OpenBidPass:
+		return game.NewPass(), nil
+	case game.OpenBidWithdraw:
+		return game.NewWithdrawal(), nil
+	case game.OpenBidClose:
+		return game.NewClose(), nil
+	default:
+		return nil, game.ErrInvalidOpenBid
+	}
+}
+
+// HandleAuctionResult tells the bot the result of an Auction
+func (p *RemotePlayer) HandleAuctionResult(auction *game.Auction) {
+	p.notify(MessageAuctionResult, &RemoteRequest{Auction: game.NewAuctionSnapshot(auction)})
+}
+
+// AddArtPieces tells the bot the ArtPieces dealt to them
+func (p *RemotePlayer) AddArtPieces(pieces []*game.ArtPiece) {
+	p.notify(MessageAddArtPieces, &RemoteRequest{ArtPieces: pieces})
+}
+
+// MoveMoney tells the bot the money they were given
+func (p *RemotePlayer) MoveMoney(amount int) {
+	p.notify(MessageMoveMoney, &RemoteRequest{Amount: amount})
+}
+
+// HandleGameResult tells the bot how the Game ended
+func (p *RemotePlayer) HandleGameResult(result *game.GameResult) {
+	p.notify(MessageGameOver, &RemoteRequest{Result: result})
+}
+
+// notify sends a notification, keeping the first error for the next decision
+func (p *RemotePlayer) notify(message string, req *RemoteRequest) {
+	ctx, cancel := context.WithTimeout(context.Background(), notificationTimeout)
+	defer cancel()
+	if _, err := p.transport.Send(ctx, message, req); err != nil && p.err == nil {
+		p.err = err
+	}
+}
+
+// decide sends a decision, failing first with the error of an earlier notification
+func (p *RemotePlayer) decide(ctx context.Context, message string, req *RemoteRequest) (*RemoteResponse, error) {
+	if p.err != nil {
+		err := p.err
+		p.err = nil
+		return nil, err
+	}
+	return p.transport.Send(ctx, message, req)
+}
+
+// artPieceInHand returns the ArtPiece in the view's hand with the name of the bot's ArtPiece, so that
+// the Game finds it. If there is none, the bot's ArtPiece is returned for the Game to reject.
+func artPieceInHand(view *game.GameView, artPiece *game.ArtPiece) *game.ArtPiece {
+	for _, piece := range view.Hand() {
+		if piece.Name == artPiece.Name {
+			return piece
+		}
+	}
+	return artPiece
+}
@@ -0,0 +1,124 @@
+package players
+
+import (
+	"context"
+	"fmt"
+	"github.com/SachinMeier/modern-art.git/game"
+)
+
+// remoteServer answers the messages of the remote protocol with a local Player
+type remoteServer struct {
+	player game.ContextPlayer
+	// local is the Player as it was given, which may implement more than ContextPlayer
+	local game.Player
+}
+
+// newRemoteServer creates a new remoteServer for the Player
+func newRemoteServer(player game.Player) *remoteServer {
+	return &remoteServer{player: game.NewContextPlayer(player), local: player}
+}
+
+// answer answers the message with the Player. Decisions are cancelled with the context.
+func (s *remoteServer) answer(ctx context.Context, message string, req *RemoteRequest) (*RemoteResponse, error) {
+	switch message {
+	case MessageHoldAuction:
+		return s.holdAuction(ctx, req)
+	case MessageOfferDouble:
+		return s.offerDouble(ctx, req)
+	case MessageSetPrice:
+		return s.setPrice(ctx, req)
+	case MessageBid:
+		return s.bid(ctx, req)
+	case MessageOpenBid:
+		return s.openBid(ctx, req)
+	case MessageAuctionResult:
+		if req.Auction == nil {
+			return nil, ErrMissingAuction
+		}
+		s.player.HandleAuctionResult(req.Auction.PublicAuction())
+	case MessageAddArtPieces:
+		s.player.AddArtPieces(req.ArtPieces)
+	case MessageMoveMoney:
+		s.player.MoveMoney(req.Amount)
+	case MessageGameOver:
+		if resultPlayer, ok := s.local.(game.ResultPlayer); ok && req.Result != nil {
+			resultPlayer.HandleGameResult(req.Result)
+		}
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownMessage, message)
+	}
+	return &RemoteResponse{}, nil
+}
+
+func (s *remoteServer) holdAuction(ctx context.Context, req *RemoteRequest) (*RemoteResponse, error) {
+	if req.View == nil {
+		return nil, ErrMissingView
+	}
+	auction, err := s.player.HoldAuctionContext(ctx, game.NewGameView(req.View))
+	if err != nil || auction == nil {
+		return &RemoteResponse{}, err
+	}
+	return &RemoteResponse{ArtPiece: auction.ArtPiece, SecondArtPiece: auction.SecondArtPiece}, nil
+}
+
+func (s *remoteServer) offerDouble(ctx context.Context, req *RemoteRequest) (*RemoteResponse, error) {
+	if err := checkRequest(req); err != nil {
+		return nil, err
+	}
+	artPiece, err := s.player.OfferDoubleContext(ctx, game.NewGameView(req.View), req.Auction.PublicAuction())
+	if err != nil {
+		return nil, err
+	}
+	return &RemoteResponse{ArtPiece: artPiece}, nil
+}
+
+func (s *remoteServer) setPrice(ctx context.Context, req *RemoteRequest) (*RemoteResponse, error) {
+	if err := checkRequest(req); err != nil {
+		return nil, err
+	}
+	price, err := s.player.SetPriceContext(ctx, game.NewGameView(req.View), req.Auction.PublicAuction())
+	if err != nil {
+		return nil, err
+	}
+	return &RemoteResponse{Price: price}, nil
+}
+
+func (s *remoteServer) bid(ctx context.Context, req *RemoteRequest) (*RemoteResponse, error) {
+	if err := checkRequest(req); err != nil {
+		return nil, err
+	}
+	bid, err := s.player.BidContext(ctx, game.NewGameView(req.View), req.Auction.PublicAuction())
+	if err != nil || bid == nil {
+		return &RemoteResponse{}, err
+	}
+	return &RemoteResponse{Bid: &bid.Value}, nil
+}
+
+func (s *remoteServer) openBid(ctx context.Context, req *RemoteRequest) (*RemoteResponse, error) {
+	if err := checkRequest(req); err != nil {
+		return nil, err
+	}
+	openBid, err := s.player.OpenBidContext(ctx, game.NewGameView(req.View), req.Auction.PublicAuction(), req.Going)
+	if err != nil {
+		return nil, err
+	}
+	if openBid == nil {
+		return nil, game.ErrInvalidOpenBid
+	}
+	resp := &RemoteResponse{Move: openBid.Type}
+	if openBid.Bid != nil {
+		resp.Bid = &openBid.Bid.Value
+	}
+	return resp, nil
+}
+
+// checkRequest checks that a decision about an Auction has its view and Auction
+func checkRequest(req *RemoteRequest) error {
+	if req.View == nil {
+		return ErrMissingView
+	}
+	if req.Auction == nil {
+		return ErrMissingAuction
+	}
+	return nil
+}
@@ -1,7 +1,10 @@
 package players
 
 import (
+	crand "crypto/rand"
+	"encoding/binary"
 	"github.com/SachinMeier/modern-art.git/game"
+	"math/rand"
 )
 
 type ArtistCount struct {
@@ -18,3 +21,13 @@ func rankedArtistCounts(phase *game.Phase) []ArtistCount {
 
 	return artistCounts
 }
+
+// newRand returns a *rand.Rand seeded from crypto/rand. Players that implement
+// game.RandPlayer use it until the Game gives them a reproducible one.
+func newRand() *rand.Rand {
+	var b [8]byte
+	if _, err := crand.Read(b[:]); err != nil {
+		panic(err)
+	}
+	return rand.New(rand.NewSource(int64(binary.LittleEndian.Uint64(b[:]))))
+}
@@ -0,0 +1,309 @@
+package game
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// DivergenceError is returned by Replay at the first Event where the replayed Game
+// differs from the record.
+type DivergenceError struct {
+	// Index is the position in the record where the replay diverged
+	Index int
+	// Expected is the recorded Event. It is nil if the replay emitted more Events than the record.
+	Expected *Event
+	// Actual is the replayed Event. It is nil if the replay stopped early or
+	// asked a Player for a decision the record does not hold.
+	Actual *Event
+	// Reason describes the divergence
+	Reason string
+	// Err is the error the replayed Game stopped with, if any
+	Err error
+}
+
+// Error returns the error message
+func (e *DivergenceError) Error() string {
+	msg := fmt.Sprintf("%s at event %d: %s", ErrReplayDiverged, e.Index, e.Reason)
+	if e.Err != nil {
+		msg = fmt.Sprintf("%s: %s", msg, e.Err)
+	}
+	return msg
+}
+
+// Is makes errors.Is(err, ErrReplayDiverged) true for a DivergenceError
+func (e *DivergenceError) Is(target error) bool {
+	return target == ErrReplayDiverged
+}
+
+// Unwrap returns the error the replayed Game stopped with
+func (e *DivergenceError) Unwrap() error {
+	return e.Err
+}
+
+// Replay re-runs a recorded Game through the engine. Every Player is replaced by a stand-in that
+// makes the recorded decisions, and every Event the engine emits is compared to the record.
+// It returns the replayed Game and a *DivergenceError at the first Event that differs,
+// so that changes to the engine can be checked against past Games.
+// The record must start with EventGameStart. Games created with WithRandSource cannot be replayed.
+func Replay(record []*Event) (*Game, error) {
+	if len(record) == 0 || record[0].Type != EventGameStart || record[0].GameStart == nil {
+		return nil, fmt.Errorf("%w: record must start with %s", ErrInvalidRecord, EventGameStart)
+	}
+	start := record[0].GameStart
+	rules := DefaultRuleSet()
+	if start.Rules != nil {
+		rules = start.Rules
+	}
+	if err := rules.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidRecord, err)
+	}
+
+	driver := newReplayDriver(record)
+	players := make([]Player, 0, len(start.Players))
+	for _, name := range start.Players {
+		players = append(players, &replayPlayer{name: name, driver: driver})
+	}
+	g, err := NewGame(players,
+		WithSeed(start.Seed),
+		WithRuleSet(rules),
+		WithMisbehaviorPolicy(start.MisbehaviorPolicy),
+		WithEventListener(driver.check),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidRecord, err)
+	}
+	_, err = g.Start()
+	if divergence := driver.result(err); divergence != nil {
+		return g, divergence
+	}
+	return g, nil
+}
+
+// replayDriver follows a replayed Game through the record. Stand-in Players look up their
+// decisions at the position of the next Event the engine should emit.
+type replayDriver struct {
+	record []*Event
+	// next is the position in the record of the next Event the replay should emit
+	next       int
+	divergence *DivergenceError
+}
+
+func newReplayDriver(record []*Event) *replayDriver {
+	return &replayDriver{
+		record: record,
+	}
+}
+
+// check is the EventListener of the replayed Game
+func (d *replayDriver) check(event *Event) {
+	if d.divergence != nil {
+		return
+	}
+	if d.next >= len(d.record) {
+		d.diverge(&DivergenceError{Index: d.next, Actual: event, Reason: "replay emitted an event past the end of the record"})
+		return
+	}
+	if !sameEvent(d.record[d.next], event) {
+		d.diverge(&DivergenceError{Index: d.next, Expected: d.record[d.next], Actual: event, Reason: "event differs from the record"})
+		return
+	}
+	d.next++
+}
+
+// sameEvent compares Events by their JSON, so that recorded Events compare equal to live ones
+func sameEvent(a, b *Event) bool {
+	aJSON, aErr := json.Marshal(a)
+	bJSON, bErr := json.Marshal(b)
+	return aErr == nil && bErr == nil && string(aJSON) == string(bJSON)
+}
+
+// diverge records the first divergence
+func (d *replayDriver) diverge(divergence *DivergenceError) {
+	if d.divergence == nil {
+		d.divergence = divergence
+	}
+}
+
+// peek returns the next recorded Event, or nil if the record is done
+func (d *replayDriver) peek() *Event {
+	if d.next >= len(d.record) {
+		return nil
+	}
+	return d.record[d.next]
+}
+
+// errRecordEnded is returned by a stand-in asked for a decision after the record ends.
+// A recorded Game that stopped with an error ends without the decision that stopped it.
+var errRecordEnded = fmt.Errorf("record ended")
+
+// unexpected records that a Player was asked for a decision the record does not hold
+func (d *replayDriver) unexpected(player string, decision string) error {
+	if d.next >= len(d.record) {
+		return errRecordEnded
+	}
+	d.diverge(&DivergenceError{
+		Index:    d.next,
+		Expected: d.record[d.next],
+		Reason:   fmt.Sprintf("%s was asked to %s", player, decision),
+	})
+	return ErrReplayDiverged
+}
+
+// result returns the divergence of a replayed Game that stopped with err, if any
+func (d *replayDriver) result(err error) *DivergenceError {
+	if d.divergence != nil {
+		if d.divergence.Err == nil {
+			d.divergence.Err = err
+		}
+		return d.divergence
+	}
+	// a recorded Game that stopped with an error is reproduced if the replay stops at the same point
+	if d.next < len(d.record) {
+		return &DivergenceError{Index: d.next, Expected: d.record[d.next], Reason: "replay stopped before the end of the record", Err: err}
+	}
+	return nil
+}
+
+// errRecordedPass is returned by a stand-in to repeat a recorded pass. The recorded
+// MisbehaviorPolicy then treats it the same way it treated the original decision.
+var errRecordedPass = fmt.Errorf("recorded pass")
+
+// replayPlayer is a stand-in Player that makes the decisions in the record
+type replayPlayer struct {
+	name   string
+	driver *replayDriver
+}
+
+// Name returns the Player's name
+func (p *replayPlayer) Name() string {
+	return p.name
+}
+
+// timedOut returns true if the next recorded Event is the player missing the deadline of a decision
+func (p *replayPlayer) timedOut() bool {
+	event := p.driver.peek()
+	return event != nil && event.Type == EventTimeout && event.Timeout.Player == p.name
+}
+
+// HoldAuction auctions the recorded ArtPieces or repeats a recorded skipped turn
+func (p *replayPlayer) HoldAuction(view *GameView) (*Auction, error) {
+	if p.timedOut() {
+		return nil, context.DeadlineExceeded
+	}
+	event := p.driver.peek()
+	switch {
+	case event == nil:
+	case event.Type == EventTurnSkipped && event.TurnSkipped.Player == p.name:
+		return nil, errRecordedPass
+	case event.Type == EventAuctionStart:
+		start := event.AuctionStart
+		// another player added the SecondArtPiece
+		if start.DoublePlayedBy == p.name {
+			return NewAuction(p, artPieceInHand(view, start.ArtPiece), nil), nil
+		}
+		if start.DoublePlayedBy == "" && start.Auctioneer == p.name {
+			auction := NewAuction(p, artPieceInHand(view, start.ArtPiece), nil)
+			if start.SecondArtPiece != nil {
+				auction.SecondArtPiece = artPieceInHand(view, start.SecondArtPiece)
+			}
+			return auction, nil
+		}
+	}
+	return nil, p.driver.unexpected(p.name, "hold an auction")
+}
+
+// OfferDouble adds the recorded SecondArtPiece if the player added it
+func (p *replayPlayer) OfferDouble(view *GameView, auction *Auction) (*ArtPiece, error) {
+	if p.timedOut() {
+		return nil, context.DeadlineExceeded
+	}
+	event := p.driver.peek()
+	if event == nil || event.Type != EventAuctionStart {
+		return nil, p.driver.unexpected(p.name, "add to a double auction")
+	}
+	start := event.AuctionStart
+	if start.DoublePlayedBy != "" && start.Auctioneer == p.name && start.SecondArtPiece != nil {
+		return artPieceInHand(view, start.SecondArtPiece), nil
+	}
+	return nil, nil
+}
+
+// SetPrice sets the recorded price or repeats a recorded pass
+func (p *replayPlayer) SetPrice(view *GameView, auction *Auction) (int, error) {
+	if p.timedOut() {
+		return 0, context.DeadlineExceeded
+	}
+	event := p.driver.peek()
+	if event != nil && event.Bid != nil && event.Bid.Bidder == p.name {
+		switch event.Type {
+		case EventSetPrice:
+			return event.Bid.Value, nil
+		case EventPass:
+			return 0, errRecordedPass
+		}
+	}
+	return 0, p.driver.unexpected(p.name, "set a price")
+}
+
+// Bid places the recorded Bid or repeats a recorded pass
+func (p *replayPlayer) Bid(view *GameView, auction *Auction) (*Bid, error) {
+	if p.timedOut() {
+		return nil, context.DeadlineExceeded
+	}
+	event := p.driver.peek()
+	if event != nil && event.Bid != nil && event.Bid.Bidder == p.name {
+		switch event.Type {
+		case EventBid:
+			return NewBid(p, event.Bid.Value), nil
+		case EventPass:
+			return nil, errRecordedPass
+		}
+	}
+	return nil, p.driver.unexpected(p.name, "bid")
+}
+
+// OpenBid makes the recorded move. A player whose move was not recorded passed.
+func (p *replayPlayer) OpenBid(view *GameView, auction *Auction, going Going) (*OpenBid, error) {
+	if p.timedOut() {
+		return nil, context.DeadlineExceeded
+	}
+	event := p.driver.peek()
+	if event == nil {
+		return nil, p.driver.unexpected(p.name, "bid in an open auction")
+	}
+	if event.Bid == nil || event.Bid.Bidder != p.name {
+		return NewPass(), nil
+	}
+	switch event.Type {
+	case EventBid:
+		return NewRaise(NewBid(p, event.Bid.Value)), nil
+	case EventWithdraw:
+		return NewWithdrawal(), nil
+	case EventClose:
+		return NewClose(), nil
+	case EventPass:
+		return nil, errRecordedPass
+	}
+	return NewPass(), nil
+}
+
+// HandleAuctionResult does nothing. The record holds the results.
+func (p *replayPlayer) HandleAuctionResult(*Auction) {}
+
+// AddArtPieces does nothing. The GameView holds the hand.
+func (p *replayPlayer) AddArtPieces([]*ArtPiece) {}
+
+// MoveMoney does nothing. The record holds the transfers.
+func (p *replayPlayer) MoveMoney(int) {}
+
+// artPieceInHand returns the ArtPiece in the player's hand with the name of the recorded one,
+// so that the Game finds it. If there is none, the recorded ArtPiece is returned for the Game to reject.
+func artPieceInHand(view *GameView, recorded *ArtPiece) *ArtPiece {
+	for _, artPiece := range view.Hand() {
+		if artPiece.Name == recorded.Name {
+			return artPiece
+		}
+	}
+	return recorded
+}
@@ -0,0 +1,170 @@
+package game_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/SachinMeier/modern-art.git/game"
+	"github.com/SachinMeier/modern-art.git/game/players"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+func TestReplaySuite(t *testing.T) {
+	suite.Run(t, new(ReplayTestSuite))
+}
+
+type ReplayTestSuite struct {
+	suite.Suite
+	testCtx    context.Context
+	cancelFunc context.CancelFunc
+}
+
+func (suite *ReplayTestSuite) SetupSuite() {}
+
+func (suite *ReplayTestSuite) SetupTest() {
+	suite.testCtx, suite.cancelFunc = context.WithCancel(context.Background())
+}
+
+func (suite *ReplayTestSuite) TearDownTest() {
+	suite.cancelFunc()
+}
+
+func (suite *ReplayTestSuite) TearDownSuite() {}
+
+func (suite *ReplayTestSuite) Test_Replay() {
+	// 1. Test that a recorded Game, including its open auctions, replays without diverging
+	{
+		ng := mustNewGame(&suite.Suite, suite.getNDummyPlayers(4), game.WithSeed(7))
+		result, err := ng.Start()
+		suite.NoError(err)
+
+		replayed, err := game.Replay(ng.Events())
+		suite.NoError(err)
+		suite.Equal(result, replayed.Result())
+		suite.Equal(len(ng.Events()), len(replayed.Events()))
+	}
+
+	// 2. Test that a record read from JSON Lines replays without diverging
+	{
+		ng := mustNewGame(&suite.Suite, suite.getNDummyPlayers(3), game.WithSeed(7))
+		_, err := ng.Start()
+		suite.NoError(err)
+
+		var buf bytes.Buffer
+		suite.NoError(game.WriteJSONLines(&buf, ng.Events()))
+		record, err := game.ReadJSONLines(&buf)
+		suite.NoError(err)
+		_, err = game.Replay(record)
+		suite.NoError(err)
+	}
+
+	// 3. Test that a Game where a player forfeited replays without diverging
+	{
+		ng := suite.newOverbidderGame(game.WithMisbehaviorPolicy(game.MisbehaviorForfeit))
+		_, err := ng.Start()
+		suite.NoError(err)
+		suite.True(ng.LookupGamePlayer("overbidder").Forfeited)
+
+		_, err = game.Replay(ng.Events())
+		suite.NoError(err)
+	}
+
+	// 4. Test that a Game that stopped with an error replays to the same point
+	{
+		ng := suite.newOverbidderGame()
+		_, err := ng.DoPhase()
+		suite.ErrorIs(err, game.ErrNotEnoughMoney)
+
+		_, err = game.Replay(ng.Events())
+		suite.NoError(err)
+	}
+}
+
+func (suite *ReplayTestSuite) Test_Divergence() {
+	// 1. Test that a changed payout is flagged at the phase end
+	{
+		ng := mustNewGame(&suite.Suite, suite.getNDummyPlayers(4), game.WithSeed(7))
+		_, err := ng.Start()
+		suite.NoError(err)
+
+		record := ng.Events()
+		idx := suite.indexOf(record, game.EventPhaseEnd)
+		record[idx].PhaseEnd.Payouts["dummy-0"] += 10
+
+		_, err = game.Replay(record)
+		suite.ErrorIs(err, game.ErrReplayDiverged)
+		var divergence *game.DivergenceError
+		suite.True(errors.As(err, &divergence))
+		suite.Equal(idx, divergence.Index)
+		suite.Equal(record[idx], divergence.Expected)
+		suite.Equal(game.EventPhaseEnd, divergence.Actual.Type)
+	}
+
+	// 2. Test that a decision the record does not hold is flagged
+	{
+		ng := mustNewGame(&suite.Suite, suite.getNDummyPlayers(4), game.WithSeed(7))
+		_, err := ng.Start()
+		suite.NoError(err)
+
+		record := ng.Events()
+		idx := suite.indexOf(record, game.EventAuctionStart)
+		record[idx].AuctionStart.Auctioneer = "nobody"
+
+		_, err = game.Replay(record)
+		var divergence *game.DivergenceError
+		suite.True(errors.As(err, &divergence))
+		suite.Equal(idx, divergence.Index)
+		suite.Nil(divergence.Actual)
+	}
+
+	// 3. Test that a truncated record is flagged
+	{
+		ng := mustNewGame(&suite.Suite, suite.getNDummyPlayers(4), game.WithSeed(7))
+		_, err := ng.Start()
+		suite.NoError(err)
+
+		record := ng.Events()[:len(ng.Events())-1]
+		_, err = game.Replay(record)
+		var divergence *game.DivergenceError
+		suite.True(errors.As(err, &divergence))
+		suite.Equal(len(record), divergence.Index)
+		suite.Nil(divergence.Expected)
+		suite.Equal(game.EventGameEnd, divergence.Actual.Type)
+	}
+
+	// 4. Test that a record without a game start is rejected
+	{
+		_, err := game.Replay([]*game.Event{})
+		suite.ErrorIs(err, game.ErrInvalidRecord)
+	}
+}
+
+// helpers
+
+// indexOf returns the position of the first Event of the EventType
+func (suite *ReplayTestSuite) indexOf(record []*game.Event, eventType game.EventType) int {
+	for i, event := range record {
+		if event.Type == eventType {
+			return i
+		}
+	}
+	suite.FailNow("event not found", eventType)
+	return -1
+}
+
+// newOverbidderGame creates a seeded Game with an overbidder in the last seat.
+// Replay deals from the full deck, so the deck is not replaced.
+func (suite *ReplayTestSuite) newOverbidderGame(opts ...game.GameOption) *game.Game {
+	ps := append(suite.getNDummyPlayers(3), newOverbidder("overbidder", -1))
+	return mustNewGame(&suite.Suite, ps, append([]game.GameOption{game.WithSeed(8)}, opts...)...)
+}
+
+func (suite *ReplayTestSuite) getNDummyPlayers(n int) []game.Player {
+	ps := make([]game.Player, n)
+	for i := 0; i < n; i++ {
+		ps[i] = players.NewDummyPlayer(fmt.Sprintf("dummy-%d", i))
+	}
+	return ps
+}
@@ -0,0 +1,118 @@
+package game
+
+import "sort"
+
+// GameResult is the outcome of a Game: the final standings and where each Player's money came from
+type GameResult struct {
+	// Standings are ordered from first place to last
+	Standings []*Standing `json:"standings"`
+}
+
+// Standing is a Player's final place and the breakdown of their money
+type Standing struct {
+	// Place is 1 for the winner. Tied Players share a Place and the Places after them are skipped.
+	Place  int    `json:"place"`
+	Player string `json:"player"`
+	Money  int    `json:"money"`
+	// Tied is true if another Player finished with the same Money
+	Tied bool `json:"tied"`
+	// PhaseMoney is the Player's money at the end of each Phase, after payouts
+	PhaseMoney []int `json:"phase_money"`
+	// Purchases is the money the Player paid for ArtPieces, by Artist
+	Purchases map[Artist]int `json:"purchases"`
+	// Sales is the money the Player received for auctioning ArtPieces, by Artist
+	Sales map[Artist]int `json:"sales"`
+	// Payouts is the money the Player was paid for their collections, by Artist
+	Payouts map[Artist]int `json:"payouts"`
+}
+
+// Result returns the standings of the Game so far, built from its Events. Players with the same
+// money share a place and are listed in seat order. The dummy collector is left out.
+func (g *Game) Result() *GameResult {
+	players := make(PlayerOrder, 0, len(g.Players))
+	for _, player := range g.Players {
+		if !isCollector(player.Player) {
+			players = append(players, player)
+		}
+	}
+	sort.SliceStable(players, func(i, j int) bool {
+		if players[i].Money != players[j].Money {
+			return players[i].Money > players[j].Money
+		}
+		return players[i].Seat < players[j].Seat
+	})
+
+	result := &GameResult{Standings: make([]*Standing, len(players))}
+	byName := make(map[string]*Standing)
+	for i, player := range players {
+		standing := &Standing{
+			Place:      i + 1,
+			Player:     player.Player.Name(),
+			Money:      player.Money,
+			PhaseMoney: []int{},
+			Purchases:  make(map[Artist]int),
+			Sales:      make(map[Artist]int),
+			Payouts:    make(map[Artist]int),
+		}
+		if i > 0 && result.Standings[i-1].Money == standing.Money {
+			standing.Place = result.Standings[i-1].Place
+			standing.Tied = true
+			result.Standings[i-1].Tied = true
+		}
+		result.Standings[i] = standing
+		byName[standing.Player] = standing
+	}
+
+	// collections are rebuilt from the Auctions to split each payout by Artist
+	collections := make(map[string]map[Artist]int)
+	for _, event := range g.events {
+		switch event.Type {
+		case EventAuctionResult:
+			auctionResult := event.AuctionResult
+			if auctionResult.EndedPhase {
+				continue
+			}
+			artist := auctionResult.ArtPieces[0].Artist
+			// the dummy collector has no Standing
+			if buyer := byName[auctionResult.Buyer]; buyer != nil {
+				buyer.Purchases[artist] += auctionResult.Price
+			}
+			if seller := byName[auctionResult.Auctioneer]; seller != nil && auctionResult.Auctioneer != auctionResult.Buyer {
+				seller.Sales[artist] += auctionResult.Price
+			}
+			if collections[auctionResult.Buyer] == nil {
+				collections[auctionResult.Buyer] = make(map[Artist]int)
+			}
+			collections[auctionResult.Buyer][artist] += len(auctionResult.ArtPieces)
+		case EventPhaseEnd:
+			for name, standing := range byName {
+				for artist, count := range collections[name] {
+					standing.Payouts[artist] += count * event.PhaseEnd.ArtistValues[artist]
+				}
+				standing.PhaseMoney = append(standing.PhaseMoney, event.PhaseEnd.Money[name])
+			}
+			collections = make(map[string]map[Artist]int)
+		}
+	}
+	return result
+}
+
+// Winners returns the names of the Players in first place
+func (r *GameResult) Winners() []string {
+	winners := make([]string, 0)
+	for _, standing := range r.Standings {
+		if standing.Place == 1 {
+			winners = append(winners, standing.Player)
+		}
+	}
+	return winners
+}
+
+// Scores returns a map of player names -> final money
+func (r *GameResult) Scores() map[string]int {
+	scores := make(map[string]int)
+	for _, standing := range r.Standings {
+		scores[standing.Player] = standing.Money
+	}
+	return scores
+}
@@ -0,0 +1,138 @@
+package game_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/SachinMeier/modern-art.git/game"
+	"github.com/SachinMeier/modern-art.git/game/players"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+func TestGameResultSuite(t *testing.T) {
+	suite.Run(t, new(GameResultTestSuite))
+}
+
+type GameResultTestSuite struct {
+	suite.Suite
+	testCtx    context.Context
+	cancelFunc context.CancelFunc
+}
+
+func (suite *GameResultTestSuite) SetupSuite() {}
+
+func (suite *GameResultTestSuite) SetupTest() {
+	suite.testCtx, suite.cancelFunc = context.WithCancel(context.Background())
+}
+
+func (suite *GameResultTestSuite) TearDownTest() {
+	suite.cancelFunc()
+}
+
+func (suite *GameResultTestSuite) TearDownSuite() {}
+
+func (suite *GameResultTestSuite) Test_Result() {
+	// 1. Test that the standings are ordered by money and the breakdown adds up to it
+	{
+		ng := mustNewGame(&suite.Suite, suite.getNDummyPlayers(4), game.WithSeed(7))
+		result, err := ng.Start()
+		suite.Require().NoError(err)
+
+		suite.Require().Equal(4, len(result.Standings))
+		for i, standing := range result.Standings {
+			suite.Equal(ng.LookupGamePlayer(standing.Player).Money, standing.Money)
+			if i > 0 {
+				suite.LessOrEqual(standing.Money, result.Standings[i-1].Money)
+			}
+			suite.Equal(len(game.AllPhases()), len(standing.PhaseMoney))
+			suite.Equal(standing.Money, standing.PhaseMoney[len(standing.PhaseMoney)-1])
+
+			money := officialRules.StartingMoney
+			for _, artist := range game.AllArtists() {
+				money += standing.Sales[artist] + standing.Payouts[artist] - standing.Purchases[artist]
+			}
+			suite.Equal(standing.Money, money)
+		}
+	}
+
+	// 2. Test that payouts by artist add up to the payouts of each phase
+	{
+		ng := mustNewGame(&suite.Suite, suite.getNDummyPlayers(3), game.WithSeed(7))
+		result, err := ng.Start()
+		suite.Require().NoError(err)
+
+		paid := make(map[string]int)
+		for _, event := range ng.Events() {
+			if event.Type == game.EventPhaseEnd {
+				for name, payout := range event.PhaseEnd.Payouts {
+					paid[name] += payout
+				}
+			}
+		}
+		for _, standing := range result.Standings {
+			total := 0
+			for _, payout := range standing.Payouts {
+				total += payout
+			}
+			suite.Equal(paid[standing.Player], total)
+		}
+	}
+
+	// 3. Test that tied players share a place and the next place is skipped
+	{
+		ng := mustNewGame(&suite.Suite, suite.getNDummyPlayers(4), game.WithSeed(7))
+		ng.LookupGamePlayer("dummy-2").Money = 200
+		ng.LookupGamePlayer("dummy-1").Money = 50
+
+		result := ng.Result()
+		places := make([]string, 0)
+		for _, standing := range result.Standings {
+			places = append(places, fmt.Sprintf("%d %s %t", standing.Place, standing.Player, standing.Tied))
+		}
+		suite.Equal([]string{"1 dummy-2 false", "2 dummy-0 true", "2 dummy-3 true", "4 dummy-1 false"}, places)
+		suite.Equal([]string{"dummy-2"}, result.Winners())
+	}
+
+	// 4. Test that the result survives a JSON round trip
+	{
+		ng := mustNewGame(&suite.Suite, suite.getNDummyPlayers(3), game.WithSeed(7))
+		result, err := ng.Start()
+		suite.Require().NoError(err)
+
+		data, err := json.Marshal(result)
+		suite.Require().NoError(err)
+		decoded := &game.GameResult{}
+		suite.Require().NoError(json.Unmarshal(data, decoded))
+		suite.Equal(result, decoded)
+	}
+
+	// 5. Test that ResultPlayers are told the result when the Game ends
+	{
+		recorder := &resultRecorder{DummyPlayer: players.NewDummyPlayer("recorder")}
+		ng := mustNewGame(&suite.Suite, append(suite.getNDummyPlayers(2), recorder), game.WithSeed(7))
+		result, err := ng.Start()
+		suite.Require().NoError(err)
+
+		suite.Require().Equal(1, len(recorder.results))
+		suite.Equal(result, recorder.results[0])
+	}
+}
+
+// resultRecorder records the GameResults it is told about
+type resultRecorder struct {
+	*players.DummyPlayer
+	results []*game.GameResult
+}
+
+func (p *resultRecorder) HandleGameResult(result *game.GameResult) {
+	p.results = append(p.results, result)
+}
+
+func (suite *GameResultTestSuite) getNDummyPlayers(n int) []game.Player {
+	ps := make([]game.Player, n)
+	for i := 0; i < n; i++ {
+		ps[i] = players.NewDummyPlayer(fmt.Sprintf("dummy-%d", i))
+	}
+	return ps
+}
@@ -1,65 +1,223 @@
 package game
 
-const (
-	MaxPlayers    = 5
-	StartingMoney = 100
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"io"
+	"os"
+	"path/filepath"
 )
 
-// RankPayouts are the payouts for the top 3 artists in a phase.
-const (
-	RankPayout1 = 30
-	RankPayout2 = 20
-	RankPayout3 = 10
-)
+// RuleSet holds the numbers that define a Game of Modern Art. DefaultRuleSet follows the official
+// rules, and house rules can change any of them with WithRuleSet.
+type RuleSet struct {
+	// Name labels the RuleSet in records and results
+	Name          string `json:"name" yaml:"name"`
+	MinPlayers    int    `json:"min_players" yaml:"min_players"`
+	MaxPlayers    int    `json:"max_players" yaml:"max_players"`
+	StartingMoney int    `json:"starting_money" yaml:"starting_money"`
+	// Phases is the number of Phases in the Game
+	Phases int `json:"phases" yaml:"phases"`
+	// ArtPiecesToEndPhase is the number of ArtPieces by one Artist that ends a Phase
+	ArtPiecesToEndPhase int `json:"art_pieces_to_end_phase" yaml:"art_pieces_to_end_phase"`
+	// RankPayouts are the payouts for the top Artists of a Phase, starting with first place
+	RankPayouts []int `json:"rank_payouts" yaml:"rank_payouts"`
+	// Tiebreakers rank Artists with the same number of ArtPieces in a Phase. The higher one wins.
+	// They must be distinct and below PointsPerArtPiece, so they never outweigh an ArtPiece.
+	Tiebreakers map[Artist]int `json:"tiebreakers" yaml:"tiebreakers"`
+	// ArtPiecesPerPhase is the number of ArtPieces dealt to each Player at the start of each Phase,
+	// by the number of seats at the table. A whole game may not deal more than the deck holds.
+	ArtPiecesPerPhase map[int][]int `json:"art_pieces_per_phase" yaml:"art_pieces_per_phase"`
+	// DummyCollector adds a seat for a dummy collector, which is dealt cards like a Player and auctions
+	// them but never bids. It is left out of the scores and standings.
+	DummyCollector bool `json:"dummy_collector" yaml:"dummy_collector"`
+	// OpenMoney lets every Player see everyone's money. In the official rules money is kept secret.
+	OpenMoney bool `json:"open_money" yaml:"open_money"`
+	// RevealBlindBids shows every Player all the bids of a blind Auction once it is over, as the
+	// official rules have everyone reveal their bids together. Otherwise only the winning bid is shown.
+	RevealBlindBids bool `json:"reveal_blind_bids" yaml:"reveal_blind_bids"`
+}
+
+// DefaultRuleSet returns the official rules for 3 to 5 players
+func DefaultRuleSet() *RuleSet {
+	return &RuleSet{
+		Name:                "official",
+		MinPlayers:          3,
+		MaxPlayers:          5,
+		StartingMoney:       100,
+		Phases:              4,
+		ArtPiecesToEndPhase: 5,
+		RankPayouts:         []int{30, 20, 10},
+		Tiebreakers: map[Artist]int{
+			Manuel: TieBreakerPoint(4),
+			Sigrid: TieBreakerPoint(3),
+			Daniel: TieBreakerPoint(2),
+			Ramon:  TieBreakerPoint(1),
+			Rafael: TieBreakerPoint(0),
+		},
+		ArtPiecesPerPhase: map[int][]int{
+			3: {10, 6, 6, 0},
+			4: {9, 4, 4, 0},
+			5: {8, 3, 3, 0},
+		},
+		RevealBlindBids: true,
+	}
+}
+
+// TwoPlayerRuleSet returns a variant of the official rules for 2 players, which the official rules do not
+// support. A dummy collector takes the third seat, so cards are dealt with the official 3-player numbers.
+func TwoPlayerRuleSet() *RuleSet {
+	rules := DefaultRuleSet()
+	rules.Name = "two-player variant"
+	rules.MinPlayers = 2
+	rules.MaxPlayers = 2
+	rules.DummyCollector = true
+	return rules
+}
+
+// Copy returns a deep copy of the RuleSet
+func (r *RuleSet) Copy() *RuleSet {
+	newRules := *r
+	newRules.RankPayouts = append([]int{}, r.RankPayouts...)
+	newRules.Tiebreakers = make(map[Artist]int)
+	for artist, tiebreaker := range r.Tiebreakers {
+		newRules.Tiebreakers[artist] = tiebreaker
+	}
+	newRules.ArtPiecesPerPhase = make(map[int][]int)
+	for players, deal := range r.ArtPiecesPerPhase {
+		newRules.ArtPiecesPerPhase[players] = append([]int{}, deal...)
+	}
+	return &newRules
+}
+
+// Validate returns ErrInvalidRuleSet if the RuleSet cannot be played
+func (r *RuleSet) Validate() error {
+	switch {
+	case r.MinPlayers < 1 || r.MaxPlayers < r.MinPlayers:
+		return fmt.Errorf("%w: player counts %d-%d", ErrInvalidRuleSet, r.MinPlayers, r.MaxPlayers)
+	case r.StartingMoney < 0:
+		return fmt.Errorf("%w: negative starting money", ErrInvalidRuleSet)
+	case r.Phases < 1:
+		return fmt.Errorf("%w: no phases", ErrInvalidRuleSet)
+	case r.ArtPiecesToEndPhase < 1:
+		return fmt.Errorf("%w: phases must end after at least one art piece", ErrInvalidRuleSet)
+	case len(r.RankPayouts) == 0 || len(r.RankPayouts) > len(AllArtists()):
+		return fmt.Errorf("%w: %d rank payouts", ErrInvalidRuleSet, len(r.RankPayouts))
+	}
+	for _, payout := range r.RankPayouts {
+		if payout < 0 {
+			return fmt.Errorf("%w: negative rank payout", ErrInvalidRuleSet)
+		}
+	}
+	seen := make(map[int]bool)
+	for _, artist := range AllArtists() {
+		tiebreaker, ok := r.Tiebreakers[artist]
+		if !ok || tiebreaker < 0 || tiebreaker >= PointsPerArtPiece || seen[tiebreaker] {
+			return fmt.Errorf("%w: tiebreaker of %s", ErrInvalidRuleSet, artist)
+		}
+		seen[tiebreaker] = true
+	}
+	for players := r.MinPlayers; players <= r.MaxPlayers; players++ {
+		deal := r.ArtPiecesPerPhase[r.seats(players)]
+		if len(deal) != r.Phases {
+			return fmt.Errorf("%w: no deal for each phase with %d players", ErrInvalidRuleSet, players)
+		}
+		dealt := 0
+		for _, count := range deal {
+			if count < 0 {
+				return fmt.Errorf("%w: negative deal with %d players", ErrInvalidRuleSet, players)
+			}
+			dealt += count * r.seats(players)
+		}
+		if deck := len(NewArtPieceDeck()); dealt > deck {
+			return fmt.Errorf("%w: deal of %d art pieces with %d players exceeds the deck of %d", ErrInvalidRuleSet, dealt, players, deck)
+		}
+	}
+	return nil
+}
 
-// MaxArtPiecesPerPhase is the minimum number of art pieces for a given artist
-// needed to end the round.
-// 5
-const MaxArtPiecesPerPhase = 5
+// ValidatePlayers returns ErrPlayerCount if the RuleSet cannot be played by the number of players
+func (r *RuleSet) ValidatePlayers(players int) error {
+	if players < r.MinPlayers || players > r.MaxPlayers {
+		return fmt.Errorf("%w: %d players, %s rules are for %d to %d", ErrPlayerCount, players, r.Name, r.MinPlayers, r.MaxPlayers)
+	}
+	return nil
+}
+
+// seats returns the number of seats at the table for the number of players
+func (r *RuleSet) seats(players int) int {
+	if r.DummyCollector {
+		return players + 1
+	}
+	return players
+}
+
+// EndPhasePoints is the number of points of an Artist that ends a Phase.
+// Use this when comparing with ArtistCounts.
+func (r *RuleSet) EndPhasePoints() int {
+	return Point(r.ArtPiecesToEndPhase)
+}
 
-// FinalPhase is the last phase. This is used to check if the game is over.
-const FinalPhase = Phase4
+// ArtPiecesToDeal returns the number of ArtPieces dealt to each seat at the start of the Phase
+func (r *RuleSet) ArtPiecesToDeal(seats int, phase PhaseNumber) int {
+	deal := r.ArtPiecesPerPhase[seats]
+	if int(phase) >= len(deal) {
+		return 0
+	}
+	return deal[phase]
+}
+
+// AddTieBreakers adds tiebreaker points to the map of artists.
+// Since Artist values are stored as 10 points per ArtPiece in the round,
+// the tiebreaker points can never mess up the order.
+func (r *RuleSet) AddTieBreakers(artists map[Artist]int) map[Artist]int {
+	// return a deep copy to avoid messing with Phase state
+	// and allow Phase.RankedArtists() to be called multiple times
+	counts := make(map[Artist]int)
+	for _, artist := range AllArtists() {
+		counts[artist] = artists[artist] + r.Tiebreakers[artist]
+	}
+	return counts
+}
+
+// ReadRuleSetJSON reads a RuleSet from JSON. Fields that are left out keep their official value.
+func ReadRuleSetJSON(r io.Reader) (*RuleSet, error) {
+	rules := DefaultRuleSet()
+	if err := json.NewDecoder(r).Decode(rules); err != nil {
+		return nil, err
+	}
+	if err := rules.Validate(); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
 
-// Tiebreakers are the tiebreaker points for each artist.
-var Tiebreakers = map[Artist]int{
-	Manuel: TieBreakerPoint(4),
-	Sigrid: TieBreakerPoint(3),
-	Daniel: TieBreakerPoint(2),
-	Ramon:  TieBreakerPoint(1),
-	Rafael: TieBreakerPoint(0),
+// ReadRuleSetYAML reads a RuleSet from YAML. Fields that are left out keep their official value.
+func ReadRuleSetYAML(r io.Reader) (*RuleSet, error) {
+	rules := DefaultRuleSet()
+	if err := yaml.NewDecoder(r).Decode(rules); err != nil {
+		return nil, err
+	}
+	if err := rules.Validate(); err != nil {
+		return nil, err
+	}
+	return rules, nil
 }
 
-// ArtPiecesPerPhase map[playerCount]map[phaseNumber]artPiecesPerPhase
-// TODO: check these numbers
-var ArtPiecesPerPhase = map[int]map[PhaseNumber]int{
-	1: {
-		Phase1: 3,
-		Phase2: 2,
-		Phase3: 2,
-		Phase4: 0,
-	},
-	2: {
-		Phase1: 12,
-		Phase2: 8,
-		Phase3: 8,
-		Phase4: 0,
-	},
-	3: {
-		Phase1: 10,
-		Phase2: 6,
-		Phase3: 6,
-		Phase4: 0,
-	},
-	4: {
-		Phase1: 8,
-		Phase2: 4,
-		Phase3: 4,
-		Phase4: 0,
-	},
-	5: {
-		Phase1: 8,
-		Phase2: 3,
-		Phase3: 3,
-		Phase4: 0,
-	},
+// LoadRuleSet reads a RuleSet from a .json, .yaml or .yml file
+func LoadRuleSet(path string) (*RuleSet, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	switch filepath.Ext(path) {
+	case ".json":
+		return ReadRuleSetJSON(bytes.NewReader(data))
+	case ".yaml", ".yml":
+		return ReadRuleSetYAML(bytes.NewReader(data))
+	default:
+		return nil, fmt.Errorf("%w: unknown file type %q", ErrInvalidRuleSet, filepath.Ext(path))
+	}
 }
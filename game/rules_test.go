@@ -0,0 +1,285 @@
+package game_test
+
+import (
+	"context"
+	"fmt"
+	"github.com/SachinMeier/modern-art.git/game"
+	"github.com/SachinMeier/modern-art.git/game/players"
+	"github.com/stretchr/testify/suite"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRuleSetSuite(t *testing.T) {
+	suite.Run(t, new(RuleSetTestSuite))
+}
+
+type RuleSetTestSuite struct {
+	suite.Suite
+	testCtx    context.Context
+	cancelFunc context.CancelFunc
+}
+
+func (suite *RuleSetTestSuite) SetupSuite() {}
+
+func (suite *RuleSetTestSuite) SetupTest() {
+	suite.testCtx, suite.cancelFunc = context.WithCancel(context.Background())
+}
+
+func (suite *RuleSetTestSuite) TearDownTest() {
+	suite.cancelFunc()
+}
+
+func (suite *RuleSetTestSuite) TearDownSuite() {}
+
+func (suite *RuleSetTestSuite) Test_DefaultRuleSet() {
+	// 1. Test that the default follows the official rules
+	{
+		rules := game.DefaultRuleSet()
+		suite.NoError(rules.Validate())
+		suite.Equal(100, rules.StartingMoney)
+		suite.Equal([]int{30, 20, 10}, rules.RankPayouts)
+		suite.Equal(game.Point(5), rules.EndPhasePoints())
+		suite.Equal(len(game.AllPhases()), rules.Phases)
+	}
+
+	// 2. Test that changing a copy does not change the original
+	{
+		rules := game.DefaultRuleSet()
+		rulesCopy := rules.Copy()
+		rulesCopy.RankPayouts[0] = 0
+		rulesCopy.Tiebreakers[game.Manuel] = 0
+		rulesCopy.ArtPiecesPerPhase[4][0] = 0
+		suite.Equal(game.DefaultRuleSet(), rules)
+	}
+
+	// 3. Test that invalid rules are rejected
+	{
+		for _, change := range []func(*game.RuleSet){
+			func(r *game.RuleSet) { r.MaxPlayers = 0 },
+			func(r *game.RuleSet) { r.StartingMoney = -1 },
+			func(r *game.RuleSet) { r.Phases = 0 },
+			func(r *game.RuleSet) { r.ArtPiecesToEndPhase = 0 },
+			func(r *game.RuleSet) { r.RankPayouts = []int{} },
+			func(r *game.RuleSet) { r.Tiebreakers[game.Manuel] = game.PointsPerArtPiece },
+			func(r *game.RuleSet) { r.Tiebreakers[game.Manuel] = r.Tiebreakers[game.Sigrid] },
+			func(r *game.RuleSet) { r.Phases = 5 },
+			func(r *game.RuleSet) { r.ArtPiecesPerPhase[3] = []int{20, 10, 10, 0} },
+		} {
+			rules := game.DefaultRuleSet()
+			change(rules)
+			suite.ErrorIs(rules.Validate(), game.ErrInvalidRuleSet)
+		}
+		rules := game.DefaultRuleSet()
+		rules.StartingMoney = -1
+		_, err := game.NewGame(suite.getNDummyPlayers(4), game.WithRuleSet(rules))
+		suite.ErrorIs(err, game.ErrInvalidRuleSet)
+	}
+
+	// 4. Test that a deal needing more ArtPieces than the deck is rejected instead of running out of cards
+	{
+		rules := game.DefaultRuleSet()
+		rules.ArtPiecesPerPhase[3] = []int{20, 10, 10, 0}
+		suite.Greater(3*40, len(game.NewArtPieceDeck()))
+		_, err := game.NewGame(suite.getNDummyPlayers(3), game.WithRuleSet(rules))
+		suite.ErrorIs(err, game.ErrInvalidRuleSet)
+
+		// the deck is dealt out exactly with 5 players
+		rules = game.DefaultRuleSet()
+		suite.NoError(rules.Validate())
+		dealt := 0
+		for _, count := range rules.ArtPiecesPerPhase[5] {
+			dealt += 5 * count
+		}
+		suite.Equal(len(game.NewArtPieceDeck()), dealt)
+	}
+}
+
+func (suite *RuleSetTestSuite) Test_PlayerCount() {
+	// 1. Test that the official rules deal the official numbers of cards to 3 to 5 players
+	{
+		rules := game.DefaultRuleSet()
+		for players, deal := range map[int][]int{3: {10, 6, 6, 0}, 4: {9, 4, 4, 0}, 5: {8, 3, 3, 0}} {
+			suite.NoError(rules.ValidatePlayers(players))
+			for _, phase := range game.AllPhases() {
+				suite.Equal(deal[phase], rules.ArtPiecesToDeal(players, phase))
+			}
+		}
+	}
+
+	// 2. Test that other numbers of players are rejected
+	{
+		for _, n := range []int{0, 1, 2, 6} {
+			_, err := game.NewGame(suite.getNDummyPlayers(n))
+			suite.ErrorIs(err, game.ErrPlayerCount)
+		}
+	}
+
+	// 3. Test that players must have different names
+	{
+		ps := append(suite.getNDummyPlayers(3), players.NewDummyPlayer("dummy-0"))
+		_, err := game.NewGame(ps)
+		suite.ErrorIs(err, game.ErrPlayerName)
+	}
+}
+
+func (suite *RuleSetTestSuite) Test_TwoPlayerVariant() {
+	// 1. Test that a dummy collector takes the third seat and is dealt the 3-player numbers
+	{
+		ng := mustNewGame(&suite.Suite, suite.getNDummyPlayers(2), game.WithSeed(7), game.WithRuleSet(game.TwoPlayerRuleSet()))
+		suite.Require().Equal(3, len(ng.Players))
+		suite.Equal(game.CollectorName, ng.Players[2].Player.Name())
+		suite.Equal([]string{"dummy-0", "dummy-1"}, ng.Events()[0].GameStart.Players)
+
+		_, err := ng.DoTurn()
+		suite.Require().NoError(err)
+		// the auctioneer has played one ArtPiece, or two for a double auction
+		deal := officialRules.ArtPiecesToDeal(3, game.Phase1)
+		for _, player := range ng.Players {
+			suite.LessOrEqual(deal-2, len(player.Hand))
+			suite.GreaterOrEqual(deal, len(player.Hand))
+		}
+	}
+
+	// 2. Test that the collector is left out of the results and the Game replays
+	{
+		ng := mustNewGame(&suite.Suite, suite.getNDummyPlayers(2), game.WithSeed(7), game.WithRuleSet(game.TwoPlayerRuleSet()))
+		result, err := ng.Start()
+		suite.Require().NoError(err)
+		suite.Equal(2, len(result.Standings))
+		suite.NotContains(result.Scores(), game.CollectorName)
+
+		_, err = game.Replay(ng.Events())
+		suite.NoError(err)
+	}
+
+	// 3. Test that the variant only supports 2 players and reserves the collector's name
+	{
+		_, err := game.NewGame(suite.getNDummyPlayers(3), game.WithRuleSet(game.TwoPlayerRuleSet()))
+		suite.ErrorIs(err, game.ErrPlayerCount)
+
+		ps := []game.Player{players.NewDummyPlayer("dummy-0"), players.NewDummyPlayer(game.CollectorName)}
+		_, err = game.NewGame(ps, game.WithRuleSet(game.TwoPlayerRuleSet()))
+		suite.ErrorIs(err, game.ErrPlayerName)
+	}
+}
+
+func (suite *RuleSetTestSuite) Test_HouseRules() {
+	// 1. Test that a Game is played with its RuleSet
+	{
+		rules := suite.houseRules()
+		ng := mustNewGame(&suite.Suite, suite.getNDummyPlayers(4), game.WithSeed(7), game.WithRuleSet(rules))
+		for _, player := range ng.Players {
+			suite.Equal(50, player.Money)
+		}
+		_, err := ng.DoPhase()
+		suite.Require().NoError(err)
+
+		phase := ng.PastPhases[0]
+		maxCount := 0
+		for _, count := range phase.ArtistCounts {
+			if count > maxCount {
+				maxCount = count
+			}
+		}
+		suite.GreaterOrEqual(maxCount, game.Point(3))
+		suite.Less(maxCount, game.Point(5))
+		payouts := phase.PhasePayouts()
+		suite.Equal(40, payouts[phase.RankedArtists()[0]])
+	}
+
+	// 2. Test that Players can read the rules but not change them
+	{
+		ng := mustNewGame(&suite.Suite, suite.getNDummyPlayers(4), game.WithSeed(7), game.WithRuleSet(suite.houseRules()))
+		view := ng.View("dummy-0")
+		suite.Equal(suite.houseRules(), view.Rules())
+		view.Rules().RankPayouts[0] = 0
+		suite.Equal(suite.houseRules(), view.Rules())
+		suite.Equal(suite.houseRules(), ng.Rules())
+	}
+
+	// 3. Test that a Game with house rules replays and resumes with them
+	{
+		ng := mustNewGame(&suite.Suite, suite.getNDummyPlayers(4), game.WithSeed(7), game.WithRuleSet(suite.houseRules()))
+		_, err := ng.Start()
+		suite.Require().NoError(err)
+
+		replayed, err := game.Replay(ng.Events())
+		suite.NoError(err)
+		suite.Equal(suite.houseRules(), replayed.Rules())
+
+		ng = mustNewGame(&suite.Suite, suite.getNDummyPlayers(4), game.WithSeed(7), game.WithRuleSet(suite.houseRules()))
+		_, err = ng.DoTurn()
+		suite.Require().NoError(err)
+		snapshot, err := ng.Snapshot()
+		suite.Require().NoError(err)
+		resumed, err := game.ResumeGame(snapshot, suite.getNDummyPlayers(4))
+		suite.Require().NoError(err)
+		suite.Equal(suite.houseRules(), resumed.Rules())
+	}
+}
+
+func (suite *RuleSetTestSuite) Test_LoadRuleSet() {
+	// 1. Test that JSON and YAML rules keep the official value of the fields they leave out
+	{
+		fromJSON, err := game.ReadRuleSetJSON(strings.NewReader(`{"name": "house", "starting_money": 50, "rank_payouts": [40, 20, 10, 5]}`))
+		suite.Require().NoError(err)
+		fromYAML, err := game.ReadRuleSetYAML(strings.NewReader("name: house\nstarting_money: 50\nrank_payouts: [40, 20, 10, 5]\n"))
+		suite.Require().NoError(err)
+
+		expected := game.DefaultRuleSet()
+		expected.Name = "house"
+		expected.StartingMoney = 50
+		expected.RankPayouts = []int{40, 20, 10, 5}
+		suite.Equal(expected, fromJSON)
+		suite.Equal(expected, fromYAML)
+	}
+
+	// 2. Test that the deal table and tiebreakers can be changed
+	{
+		rules, err := game.ReadRuleSetYAML(strings.NewReader(
+			"art_pieces_per_phase:\n  4: [10, 4, 3, 0]\ntiebreakers:\n  Manuel Carvalho: 0\n  Rafael Silvera: 4\n"))
+		suite.Require().NoError(err)
+		suite.Equal(10, rules.ArtPiecesToDeal(4, game.Phase1))
+		suite.Equal(8, rules.ArtPiecesToDeal(5, game.Phase1))
+		suite.Equal(4, rules.Tiebreakers[game.Rafael])
+	}
+
+	// 3. Test that files are read by their extension and invalid rules are rejected
+	{
+		dir := suite.T().TempDir()
+		jsonPath := filepath.Join(dir, "rules.json")
+		suite.Require().NoError(os.WriteFile(jsonPath, []byte(`{"starting_money": 50}`), 0o644))
+		rules, err := game.LoadRuleSet(jsonPath)
+		suite.Require().NoError(err)
+		suite.Equal(50, rules.StartingMoney)
+
+		yamlPath := filepath.Join(dir, "rules.yml")
+		suite.Require().NoError(os.WriteFile(yamlPath, []byte("starting_money: -1\n"), 0o644))
+		_, err = game.LoadRuleSet(yamlPath)
+		suite.ErrorIs(err, game.ErrInvalidRuleSet)
+
+		_, err = game.LoadRuleSet(filepath.Join(dir, "rules.txt"))
+		suite.Error(err)
+	}
+}
+
+// houseRules are rules with less money, shorter phases and a payout for fourth place
+func (suite *RuleSetTestSuite) houseRules() *game.RuleSet {
+	rules := game.DefaultRuleSet()
+	rules.Name = "house"
+	rules.StartingMoney = 50
+	rules.ArtPiecesToEndPhase = 3
+	rules.RankPayouts = []int{40, 20, 10, 5}
+	return rules
+}
+
+func (suite *RuleSetTestSuite) getNDummyPlayers(n int) []game.Player {
+	ps := make([]game.Player, n)
+	for i := 0; i < n; i++ {
+		ps[i] = players.NewDummyPlayer(fmt.Sprintf("dummy-%d", i))
+	}
+	return ps
+}
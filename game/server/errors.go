@@ -0,0 +1,13 @@
+package server
+
+import "fmt"
+
+var (
+	ErrInvalidRoom  = fmt.Errorf("room needs a name without slashes and at least one player seat")
+	ErrRoomExists   = fmt.Errorf("room already exists")
+	ErrRoomNotFound = fmt.Errorf("room not found")
+	ErrUnknownBot   = fmt.Errorf("unknown kind of bot")
+	ErrRoomFull     = fmt.Errorf("room has no free seat")
+	ErrInvalidToken = fmt.Errorf("reconnect token does not match a seat")
+	ErrGamePanic    = fmt.Errorf("game panicked")
+)
@@ -0,0 +1,75 @@
+package server
+
+import (
+	"github.com/SachinMeier/modern-art.git/game"
+	"github.com/SachinMeier/modern-art.git/game/players"
+)
+
+// Messages sent by the server, besides those of the remote protocol of package players
+const (
+	// MessageJoined tells a connection which seat it took and the token to reconnect to it
+	MessageJoined = "joined"
+	// MessageRoom tells every seat the state of the Room whenever it changes
+	MessageRoom = "room"
+	// MessageEvent tells every seat an Event of the Game that the rules make public
+	MessageEvent = "event"
+)
+
+// Message is a message from the server to a seat, sent as a WebSocket text message of JSON
+type Message struct {
+	// ID is set for decisions, which are answered with a Reply of the same ID
+	ID   int    `json:"id,omitempty"`
+	Type string `json:"type"`
+	// Seat is set for joined
+	Seat *SeatInfo `json:"seat,omitempty"`
+	// Token is set for joined
+	Token string `json:"token,omitempty"`
+	// Room is set for room
+	Room *RoomInfo `json:"room,omitempty"`
+	// Event is set for event
+	Event *game.Event `json:"event,omitempty"`
+	// Events is set for joined, with the public Events of the Game so far
+	Events []*game.Event `json:"events,omitempty"`
+	*players.RemoteRequest
+}
+
+// Reply is the answer of a seat to a decision
+type Reply struct {
+	// ID is the ID of the decision answered
+	ID int `json:"id"`
+	players.RemoteResponse
+}
+
+// RoomState is the stage a Room is at
+type RoomState string
+
+// RoomStates
+const (
+	// RoomWaiting Rooms wait for their seats to be taken
+	RoomWaiting RoomState = "waiting"
+	// RoomPlaying Rooms are playing their Game
+	RoomPlaying RoomState = "playing"
+	// RoomOver Rooms have played their Game
+	RoomOver RoomState = "over"
+)
+
+// RoomInfo is the public state of a Room
+type RoomInfo struct {
+	Name  string      `json:"name"`
+	State RoomState   `json:"state"`
+	Seats []*SeatInfo `json:"seats"`
+	// Result is set once the Game is over
+	Result *game.GameResult `json:"result,omitempty"`
+	// Error is set if the Game stopped with an error
+	Error string `json:"error,omitempty"`
+}
+
+// SeatInfo is the public state of a seat
+type SeatInfo struct {
+	Seat int `json:"seat"`
+	// Player is the name of the Player in the seat, or empty while the seat is free
+	Player string `json:"player,omitempty"`
+	Bot    bool   `json:"bot"`
+	// Connected is true if a connection holds the seat. Bots are always connected.
+	Connected bool `json:"connected"`
+}
@@ -0,0 +1,282 @@
+package server
+
+import (
+	"context"
+	crand "crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"github.com/SachinMeier/modern-art.git/game"
+	"github.com/SachinMeier/modern-art.git/game/players"
+	"github.com/gorilla/websocket"
+	"io"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// RoomConfig describes a Room to create
+type RoomConfig struct {
+	Name string `json:"name"`
+	// Players is the number of seats taken by players who join over WebSocket. They sit first.
+	Players int `json:"players"`
+	// Bots are the kinds of the bots that take the seats after the players'
+	Bots []string `json:"bots,omitempty"`
+	// Rules are the rules of the Game. The official rules are used if they are left out.
+	Rules *game.RuleSet `json:"rules,omitempty"`
+	// Seed is the seed of the Game. 0 picks a random seed.
+	Seed int64 `json:"seed,omitempty"`
+	// DecisionTimeout is the number of seconds a player has for each decision. 0 gives them all the time they need.
+	DecisionTimeout int `json:"decision_timeout,omitempty"`
+}
+
+// Room is a table where a Game is played once every seat is taken
+type Room struct {
+	name   string
+	config *RoomConfig
+	rules  *game.RuleSet
+	ctx    context.Context
+	done   chan struct{}
+
+	mu     sync.Mutex
+	state  RoomState
+	seats  []*seat
+	result *game.GameResult
+	err    error
+
+	// eventsMu guards events, and keeps a joining seat from missing an Event or being sent one twice
+	eventsMu sync.Mutex
+	events   []*game.Event
+}
+
+// newRoom creates a Room whose Game is cancelled with ctx. Bot seats are taken right away.
+func newRoom(ctx context.Context, config *RoomConfig, bots map[string]func(name string) game.Player) (*Room, error) {
+	rules := game.DefaultRuleSet()
+	if config.Rules != nil {
+		rules = config.Rules.Copy()
+	}
+	if err := rules.Validate(); err != nil {
+		return nil, err
+	}
+	if err := rules.ValidatePlayers(config.Players + len(config.Bots)); err != nil {
+		return nil, err
+	}
+	r := &Room{
+		name:   config.Name,
+		config: config,
+		rules:  rules,
+		ctx:    ctx,
+		done:   make(chan struct{}),
+		state:  RoomWaiting,
+	}
+	for i := 0; i < config.Players; i++ {
+		r.seats = append(r.seats, newSeat(i))
+	}
+	for i, kind := range config.Bots {
+		newBot, ok := bots[kind]
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", ErrUnknownBot, kind)
+		}
+		s := newSeat(config.Players + i)
+		s.player = fmt.Sprintf("%s-%d", kind, s.index)
+		s.bot = newBot(s.player)
+		r.seats = append(r.seats, s)
+	}
+	return r, nil
+}
+
+// Name returns the Room's name
+func (r *Room) Name() string {
+	return r.name
+}
+
+// Done is closed once the Room's Game is over
+func (r *Room) Done() <-chan struct{} {
+	return r.done
+}
+
+// Result returns the GameResult once the Game is over, or the error it stopped with
+func (r *Room) Result() (*game.GameResult, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.result, r.err
+}
+
+// Info returns the public state of the Room
+func (r *Room) Info() *RoomInfo {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.infoLocked()
+}
+
+// infoLocked returns the public state of the Room. r.mu must be held.
+func (r *Room) infoLocked() *RoomInfo {
+	info := &RoomInfo{
+		Name:   r.name,
+		State:  r.state,
+		Seats:  make([]*SeatInfo, 0, len(r.seats)),
+		Result: r.result,
+	}
+	for _, s := range r.seats {
+		info.Seats = append(info.Seats, s.info())
+	}
+	if r.err != nil {
+		info.Error = r.err.Error()
+	}
+	return info
+}
+
+// claim returns the seat of the token, or takes the first free seat for a new player of the name.
+// An empty name is replaced by one made from the seat.
+func (r *Room) claim(token string, name string) (*seat, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if token != "" {
+		for _, s := range r.seats {
+			if s.bot == nil && s.token == token {
+				return s, false, nil
+			}
+		}
+		return nil, false, ErrInvalidToken
+	}
+	if r.state != RoomWaiting {
+		return nil, false, ErrRoomFull
+	}
+	var free *seat
+	for _, s := range r.seats {
+		if s.player == "" && free == nil {
+			free = s
+		}
+		if name != "" && s.player == name {
+			return nil, false, fmt.Errorf("%w: %q", game.ErrPlayerName, name)
+		}
+	}
+	if free == nil {
+		return nil, false, ErrRoomFull
+	}
+	if name == "" {
+		name = fmt.Sprintf("player-%d", free.index)
+	}
+	free.player = name
+	free.token = newToken()
+	return free, true, nil
+}
+
+// release frees a seat that was claimed by a connection that never held it
+func (r *Room) release(s *seat) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.state == RoomWaiting {
+		s.player, s.token = "", ""
+	}
+}
+
+// join holds the seat with the connection until it closes. The Game starts once every seat is taken.
+func (r *Room) join(s *seat, conn *websocket.Conn) {
+	r.eventsMu.Lock()
+	s.attach(conn, r.name, append([]*game.Event{}, r.events...))
+	r.eventsMu.Unlock()
+	r.startIfFull()
+	r.broadcast()
+	s.read(conn)
+	if s.detach(conn) {
+		r.broadcast()
+	}
+}
+
+// startIfFull starts the Game in its own goroutine if every seat is taken and it has not started yet
+func (r *Room) startIfFull() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.state != RoomWaiting {
+		return
+	}
+	for _, s := range r.seats {
+		if s.player == "" {
+			return
+		}
+	}
+
+	ps := make([]game.Player, len(r.seats))
+	for i, s := range r.seats {
+		if s.bot != nil {
+			ps[i] = s.bot
+		} else {
+			ps[i] = players.NewRemotePlayer(s.player, s)
+		}
+	}
+	seed := r.config.Seed
+	for seed == 0 {
+		seed = rand.Int63()
+	}
+	opts := []game.GameOption{
+		game.WithRuleSet(r.rules),
+		game.WithSeed(seed),
+		game.WithContext(r.ctx),
+		game.WithEventListener(game.PublicEvents(r.rules, r.event)),
+	}
+	if r.config.DecisionTimeout > 0 {
+		opts = append(opts, game.WithDecisionTimeout(time.Duration(r.config.DecisionTimeout)*time.Second))
+	}
+	r.state = RoomPlaying
+	go r.play(ps, opts)
+}
+
+// play plays the Game and tells every seat how it ended
+func (r *Room) play(ps []game.Player, opts []game.GameOption) {
+	result, err := startGame(ps, opts)
+	for _, p := range ps {
+		if closer, ok := p.(io.Closer); ok {
+			_ = closer.Close()
+		}
+	}
+
+	r.mu.Lock()
+	r.state, r.result, r.err = RoomOver, result, err
+	r.mu.Unlock()
+	r.broadcast()
+	close(r.done)
+}
+
+// startGame creates and plays the Game, and returns ErrGamePanic instead of panicking
+func startGame(ps []game.Player, opts []game.GameOption) (result *game.GameResult, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			result, err = nil, fmt.Errorf("%w: %v", ErrGamePanic, r)
+		}
+	}()
+	g, err := game.NewGame(ps, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return g.Start()
+}
+
+// broadcast sends the state of the Room to every connected seat
+func (r *Room) broadcast() {
+	r.mu.Lock()
+	info := r.infoLocked()
+	seats := append([]*seat{}, r.seats...)
+	r.mu.Unlock()
+	for _, s := range seats {
+		s.write(&Message{Type: MessageRoom, Room: info})
+	}
+}
+
+// event records a public Event of the Game and sends it to every connected seat
+func (r *Room) event(event *game.Event) {
+	r.eventsMu.Lock()
+	defer r.eventsMu.Unlock()
+	r.events = append(r.events, event)
+	for _, s := range r.seats {
+		s.write(&Message{Type: MessageEvent, Event: event})
+	}
+}
+
+// newToken returns a random reconnect token
+func newToken() string {
+	var b [16]byte
+	if _, err := crand.Read(b[:]); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b[:])
+}
@@ -0,0 +1,179 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/SachinMeier/modern-art.git/game"
+	"github.com/SachinMeier/modern-art.git/game/players"
+	"github.com/gorilla/websocket"
+	"sync"
+)
+
+// decisions are the messages of the remote protocol that a seat must answer
+var decisions = map[string]bool{
+	players.MessageHoldAuction: true,
+	players.MessageOfferDouble: true,
+	players.MessageSetPrice:    true,
+	players.MessageBid:         true,
+	players.MessageOpenBid:     true,
+}
+
+// seat is a seat at the table of a Room. A seat taken over WebSocket is the RemoteTransport of its Player,
+// and keeps the decision it is waiting for so that a reconnecting connection is asked again.
+type seat struct {
+	index int
+	// player is the name of the Player in the seat, or empty while it is free
+	player string
+	// bot is the Player of a bot seat
+	bot   game.Player
+	token string
+
+	// mu guards conn and pending, and serializes writes to conn
+	mu      sync.Mutex
+	conn    *websocket.Conn
+	pending *Message
+	replies chan *Reply
+	// nextID is only used by the Game
+	nextID int
+}
+
+// newSeat creates a free seat
+func newSeat(index int) *seat {
+	return &seat{
+		index:   index,
+		replies: make(chan *Reply, 1),
+	}
+}
+
+// info returns the public state of the seat
+func (s *seat) info() *SeatInfo {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return &SeatInfo{
+		Seat:      s.index,
+		Player:    s.player,
+		Bot:       s.bot != nil,
+		Connected: s.bot != nil || s.conn != nil,
+	}
+}
+
+// Send sends the message of the remote protocol to the seat. Notifications are not answered, so a seat
+// that is not connected misses them. Decisions wait for the seat's Reply, across reconnects.
+func (s *seat) Send(ctx context.Context, message string, req *players.RemoteRequest) (*players.RemoteResponse, error) {
+	msg := &Message{Type: message, RemoteRequest: req}
+	if !decisions[message] {
+		s.write(msg)
+		return &players.RemoteResponse{}, nil
+	}
+	s.nextID++
+	msg.ID = s.nextID
+	s.mu.Lock()
+	s.pending = msg
+	s.writeLocked(msg)
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		s.pending = nil
+		s.mu.Unlock()
+	}()
+
+	for {
+		select {
+		case reply := <-s.replies:
+			if reply.ID != msg.ID {
+				continue
+			}
+			if reply.Error != "" {
+				return nil, fmt.Errorf("%s: %s", message, reply.Error)
+			}
+			return &reply.RemoteResponse, nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// attach makes the connection hold the seat, closing the one that held it before.
+// The connection is told it joined with the Events so far, and asked the pending decision again.
+func (s *seat) attach(conn *websocket.Conn, room string, events []*game.Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.conn != nil {
+		_ = s.conn.Close()
+	}
+	s.conn = conn
+	s.writeLocked(&Message{
+		Type:   MessageJoined,
+		Seat:   &SeatInfo{Seat: s.index, Player: s.player, Connected: true},
+		Token:  s.token,
+		Room:   &RoomInfo{Name: room},
+		Events: events,
+	})
+	if s.pending != nil {
+		s.writeLocked(s.pending)
+	}
+}
+
+// detach frees the seat of the connection, unless another connection has taken it since.
+// It returns true if the connection held the seat.
+func (s *seat) detach(conn *websocket.Conn) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.conn != conn {
+		return false
+	}
+	_ = s.conn.Close()
+	s.conn = nil
+	return true
+}
+
+// read passes the Replies of the connection to the decision waiting for them until the connection closes
+func (s *seat) read(conn *websocket.Conn) {
+	for {
+		reply := &Reply{}
+		if err := conn.ReadJSON(reply); err != nil {
+			// a malformed Reply is skipped, so the decision waits for a good one
+			if isJSONError(err) {
+				continue
+			}
+			return
+		}
+		s.mu.Lock()
+		waiting := s.pending != nil && s.pending.ID == reply.ID
+		s.mu.Unlock()
+		if !waiting {
+			continue
+		}
+		select {
+		case s.replies <- reply:
+		default:
+		}
+	}
+}
+
+// write sends the message to the connection holding the seat, if any
+func (s *seat) write(msg *Message) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.writeLocked(msg)
+}
+
+// writeLocked sends the message to the connection holding the seat, if any. s.mu must be held.
+// A connection that cannot be written to is closed, which ends its read loop.
+func (s *seat) writeLocked(msg *Message) {
+	if s.conn == nil {
+		return
+	}
+	if err := s.conn.WriteJSON(msg); err != nil {
+		_ = s.conn.Close()
+	}
+}
+
+// isJSONError returns true if the error is of a message that is not a JSON Reply
+func isJSONError(err error) bool {
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	return errors.As(err, &syntaxErr) || errors.As(err, &typeErr)
+}
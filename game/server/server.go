@@ -0,0 +1,206 @@
+package server
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/SachinMeier/modern-art.git/game"
+	"github.com/gorilla/websocket"
+	"io/fs"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+)
+
+/*
+Server hosts Games in named Rooms. Players join the seats of a Room over WebSocket and the Room's Game starts once
+every seat is taken. Each seat is sent the messages of the remote protocol of package players for its own Player
+only, and answers its decisions with a Reply. A seat is given a reconnect token when it is taken, with which a new
+connection can claim it again, for example after a page reload.
+
+A seat is also sent the public Events of its Game as they happen. The Server serves a browser client at / with which
+people can create, join and play in Rooms.
+
+Routes:
+  - GET / and its files serve the browser client
+  - GET /rooms lists the Rooms
+  - POST /rooms creates a Room from a RoomConfig
+  - GET /rooms/{name} returns a Room
+  - GET /rooms/{name}/ws joins a Room over WebSocket, as the player named by ?player= or the seat of ?token=
+  - GET /bots lists the kinds of bots Rooms can seat
+*/
+
+// web holds the files of the browser client
+//
+//go:embed web
+var web embed.FS
+
+// Server hosts Games in Rooms
+type Server struct {
+	bots     map[string]func(name string) game.Player
+	ctx      context.Context
+	cancel   context.CancelFunc
+	upgrader websocket.Upgrader
+	client   http.Handler
+
+	mu    sync.Mutex
+	rooms map[string]*Room
+}
+
+// NewServer creates a new Server. Rooms can seat the kinds of bots, each created by its function with its name.
+func NewServer(bots map[string]func(name string) game.Player) *Server {
+	ctx, cancel := context.WithCancel(context.Background())
+	files, err := fs.Sub(web, "web")
+	if err != nil {
+		panic(err)
+	}
+	return &Server{
+		bots:   bots,
+		ctx:    ctx,
+		cancel: cancel,
+		client: http.FileServer(http.FS(files)),
+		rooms:  make(map[string]*Room),
+	}
+}
+
+// Bots returns the kinds of bots Rooms can seat, by name
+func (s *Server) Bots() []string {
+	kinds := make([]string, 0, len(s.bots))
+	for kind := range s.bots {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	return kinds
+}
+
+// Close cancels the Games in progress
+func (s *Server) Close() {
+	s.cancel()
+}
+
+// CreateRoom creates a Room. Its bots take their seats right away.
+func (s *Server) CreateRoom(config *RoomConfig) (*Room, error) {
+	if config.Name == "" || strings.Contains(config.Name, "/") || config.Players < 1 {
+		return nil, ErrInvalidRoom
+	}
+	room, err := newRoom(s.ctx, config, s.bots)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.rooms[config.Name]; ok {
+		return nil, fmt.Errorf("%w: %q", ErrRoomExists, config.Name)
+	}
+	s.rooms[config.Name] = room
+	return room, nil
+}
+
+// Room returns the Room of the name
+func (s *Server) Room(name string) (*Room, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	room, ok := s.rooms[name]
+	return room, ok
+}
+
+// Rooms returns the public state of every Room, by name
+func (s *Server) Rooms() []*RoomInfo {
+	s.mu.Lock()
+	rooms := make([]*Room, 0, len(s.rooms))
+	for _, room := range s.rooms {
+		rooms = append(rooms, room)
+	}
+	s.mu.Unlock()
+
+	infos := make([]*RoomInfo, 0, len(rooms))
+	for _, room := range rooms {
+		infos = append(infos, room.Info())
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Name < infos[j].Name })
+	return infos
+}
+
+// ServeHTTP serves the routes of the Server
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.Trim(r.URL.Path, "/")
+	parts := strings.Split(path, "/")
+	switch {
+	case path == "rooms" && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, s.Rooms())
+	case path == "rooms" && r.Method == http.MethodPost:
+		s.createRoom(w, r)
+	case len(parts) == 2 && parts[0] == "rooms" && r.Method == http.MethodGet:
+		room, ok := s.Room(parts[1])
+		if !ok {
+			writeError(w, http.StatusNotFound, ErrRoomNotFound)
+			return
+		}
+		writeJSON(w, http.StatusOK, room.Info())
+	case len(parts) == 3 && parts[0] == "rooms" && parts[2] == "ws":
+		s.joinRoom(w, r, parts[1])
+	case path == "bots" && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, s.Bots())
+	case parts[0] != "rooms" && parts[0] != "bots" && r.Method == http.MethodGet:
+		s.client.ServeHTTP(w, r)
+	default:
+		writeError(w, http.StatusNotFound, fmt.Errorf("no route for %s %s", r.Method, r.URL.Path))
+	}
+}
+
+// createRoom creates the Room of the RoomConfig in the request body
+func (s *Server) createRoom(w http.ResponseWriter, r *http.Request) {
+	config := &RoomConfig{}
+	if err := json.NewDecoder(r.Body).Decode(config); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	room, err := s.CreateRoom(config)
+	if errors.Is(err, ErrRoomExists) {
+		writeError(w, http.StatusConflict, err)
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, room.Info())
+}
+
+// joinRoom claims a seat of the Room and upgrades the request to a WebSocket that holds it
+func (s *Server) joinRoom(w http.ResponseWriter, r *http.Request, name string) {
+	room, ok := s.Room(name)
+	if !ok {
+		writeError(w, http.StatusNotFound, ErrRoomNotFound)
+		return
+	}
+	seat, claimed, err := room.claim(r.URL.Query().Get("token"), r.URL.Query().Get("player"))
+	if err != nil {
+		writeError(w, http.StatusConflict, err)
+		return
+	}
+	conn, err := s.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// the upgrader has answered the request
+		if claimed {
+			room.release(seat)
+		}
+		return
+	}
+	room.join(seat, conn)
+}
+
+// writeJSON writes the value as JSON with the status
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes the error as JSON with the status
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
@@ -0,0 +1,334 @@
+package server_test
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/SachinMeier/modern-art.git/game"
+	"github.com/SachinMeier/modern-art.git/game/players"
+	"github.com/SachinMeier/modern-art.git/game/server"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestServerSuite(t *testing.T) {
+	suite.Run(t, new(ServerTestSuite))
+}
+
+type ServerTestSuite struct {
+	suite.Suite
+	testCtx    context.Context
+	cancelFunc context.CancelFunc
+	srv        *server.Server
+	httpServer *httptest.Server
+}
+
+func (suite *ServerTestSuite) SetupSuite() {}
+
+func (suite *ServerTestSuite) SetupTest() {
+	suite.testCtx, suite.cancelFunc = context.WithCancel(context.Background())
+	suite.srv = server.NewServer(map[string]func(name string) game.Player{
+		"alpha": func(name string) game.Player { return players.NewAlphaPlayer(name) },
+	})
+	suite.httpServer = httptest.NewServer(suite.srv)
+}
+
+func (suite *ServerTestSuite) TearDownTest() {
+	suite.srv.Close()
+	suite.httpServer.Close()
+	suite.cancelFunc()
+}
+
+func (suite *ServerTestSuite) TearDownSuite() {}
+
+func (suite *ServerTestSuite) Test_Rooms() {
+	// 1. Test that rooms are created and listed over HTTP, and that invalid rooms are rejected
+	{
+		resp, err := http.Post(suite.httpServer.URL+"/rooms", "application/json", strings.NewReader(`{"name": "lunch", "players": 2, "bots": ["alpha"]}`))
+		suite.Require().NoError(err)
+		resp.Body.Close()
+		suite.Equal(http.StatusCreated, resp.StatusCode)
+
+		for _, body := range []string{
+			`{"name": "lunch", "players": 2}`,
+			`{"name": "solo", "players": 1}`,
+			`{"name": "robots", "players": 1, "bots": ["gamma", "alpha"]}`,
+			`{"name": "", "players": 3}`,
+		} {
+			resp, err := http.Post(suite.httpServer.URL+"/rooms", "application/json", strings.NewReader(body))
+			suite.Require().NoError(err)
+			resp.Body.Close()
+			suite.GreaterOrEqual(resp.StatusCode, 400, body)
+		}
+
+		rooms := suite.srv.Rooms()
+		suite.Require().Equal(1, len(rooms))
+		suite.Equal("lunch", rooms[0].Name)
+		suite.Equal(server.RoomWaiting, rooms[0].State)
+		suite.Equal(3, len(rooms[0].Seats))
+		suite.Equal("alpha-2", rooms[0].Seats[2].Player)
+		suite.True(rooms[0].Seats[2].Bot)
+	}
+}
+
+func (suite *ServerTestSuite) Test_Play() {
+	// 1. Test that the Game starts once the seats are taken and each seat is only sent its own decisions
+	{
+		room, err := suite.srv.CreateRoom(&server.RoomConfig{Name: "lunch", Players: 2, Bots: []string{"alpha"}, Seed: 3})
+		suite.Require().NoError(err)
+
+		ann := suite.dial("lunch", "player=ann")
+		joined := suite.readUntil(ann, server.MessageJoined)
+		suite.Equal("ann", joined.Seat.Player)
+		suite.Equal(0, joined.Seat.Seat)
+		suite.NotEmpty(joined.Token)
+		suite.Equal(server.RoomWaiting, suite.readUntil(ann, server.MessageRoom).Room.State)
+
+		// the name is taken
+		_, resp, err := websocket.DefaultDialer.Dial(suite.wsURL("lunch", "player=ann"), nil)
+		suite.Require().Error(err)
+		suite.Equal(http.StatusConflict, resp.StatusCode)
+
+		bob := suite.dial("lunch", "player=bob")
+		done := make(chan []string)
+		go func() { done <- suite.play(bob, "bob") }()
+		annViews := suite.play(ann, "ann")
+		bobViews := <-done
+
+		<-room.Done()
+		result, err := room.Result()
+		suite.Require().NoError(err)
+		suite.Equal(3, len(result.Standings))
+		suite.NotEmpty(annViews)
+		suite.NotEmpty(bobViews)
+		for _, player := range annViews {
+			suite.Equal("ann", player)
+		}
+		for _, player := range bobViews {
+			suite.Equal("bob", player)
+		}
+		suite.Equal(server.RoomOver, room.Info().State)
+	}
+}
+
+func (suite *ServerTestSuite) Test_Reconnect() {
+	// 1. Test that a seat is claimed again with its token and asked its pending decision again
+	{
+		room, err := suite.srv.CreateRoom(&server.RoomConfig{Name: "lunch", Players: 1, Bots: []string{"alpha", "alpha"}, Seed: 3})
+		suite.Require().NoError(err)
+
+		first := suite.dial("lunch", "")
+		joined := suite.readUntil(first, server.MessageJoined)
+		suite.Equal("player-0", joined.Seat.Player)
+		decision := suite.readDecision(first)
+		first.Close()
+
+		// a wrong token is rejected
+		_, resp, err := websocket.DefaultDialer.Dial(suite.wsURL("lunch", "token=nope"), nil)
+		suite.Require().Error(err)
+		suite.Equal(http.StatusConflict, resp.StatusCode)
+
+		second := suite.dial("lunch", "token="+joined.Token)
+		rejoined := suite.readUntil(second, server.MessageJoined)
+		suite.Equal(joined.Seat.Seat, rejoined.Seat.Seat)
+		// the public Events so far are sent again
+		suite.Require().NotEmpty(rejoined.Events)
+		suite.Equal(game.EventGameStart, rejoined.Events[0].Type)
+		suite.Equal(int64(0), rejoined.Events[0].GameStart.Seed)
+		again := suite.readDecision(second)
+		suite.Equal(decision.ID, again.ID)
+		suite.Equal(decision.Type, again.Type)
+
+		suite.Require().NoError(second.WriteJSON(answer(again)))
+		suite.play(second, "player-0")
+		<-room.Done()
+		_, err = room.Result()
+		suite.NoError(err)
+	}
+}
+
+func (suite *ServerTestSuite) Test_Events() {
+	// 1. Test that every seat is sent the public Events of the Game as they happen
+	{
+		room, err := suite.srv.CreateRoom(&server.RoomConfig{Name: "lunch", Players: 1, Bots: []string{"alpha", "alpha"}, Seed: 3})
+		suite.Require().NoError(err)
+
+		conn := suite.dial("lunch", "player=ann")
+		defer conn.Close()
+		events := []*game.Event{}
+		for {
+			msg := suite.read(conn)
+			if msg.Type == server.MessageEvent {
+				events = append(events, msg.Event)
+			}
+			if msg.Type == players.MessageGameOver {
+				break
+			}
+			if msg.ID != 0 {
+				suite.Require().NoError(conn.WriteJSON(answer(msg)))
+			}
+		}
+		<-room.Done()
+
+		suite.Require().NotEmpty(events)
+		suite.Equal(game.EventGameStart, events[0].Type)
+		suite.Equal(game.EventGameEnd, events[len(events)-1].Type)
+		for _, event := range events {
+			suite.NotEqual(game.EventDeal, event.Type)
+			suite.NotEqual(game.EventTransfer, event.Type)
+		}
+	}
+}
+
+func (suite *ServerTestSuite) Test_Client() {
+	// 1. Test that the browser client and the kinds of bots are served
+	{
+		for path, contentType := range map[string]string{"/": "text/html", "/app.js": "javascript", "/style.css": "text/css"} {
+			resp, err := http.Get(suite.httpServer.URL + path)
+			suite.Require().NoError(err)
+			resp.Body.Close()
+			suite.Equal(http.StatusOK, resp.StatusCode, path)
+			suite.Contains(resp.Header.Get("Content-Type"), contentType, path)
+		}
+
+		resp, err := http.Get(suite.httpServer.URL + "/missing.js")
+		suite.Require().NoError(err)
+		resp.Body.Close()
+		suite.Equal(http.StatusNotFound, resp.StatusCode)
+
+		resp, err = http.Get(suite.httpServer.URL + "/bots")
+		suite.Require().NoError(err)
+		defer resp.Body.Close()
+		bots := []string{}
+		suite.Require().NoError(json.NewDecoder(resp.Body).Decode(&bots))
+		suite.Equal([]string{"alpha"}, bots)
+	}
+}
+
+func (suite *ServerTestSuite) Test_Panic() {
+	// 1. Test that a Game that panics ends its Room with an error instead of crashing the Server
+	{
+		srv := server.NewServer(map[string]func(name string) game.Player{
+			"alpha":    func(name string) game.Player { return players.NewAlphaPlayer(name) },
+			"panicker": func(name string) game.Player { return &panicker{AlphaPlayer: players.NewAlphaPlayer(name)} },
+		})
+		defer srv.Close()
+		room, err := srv.CreateRoom(&server.RoomConfig{Name: "lunch", Players: 1, Bots: []string{"panicker", "alpha"}, Seed: 3})
+		suite.Require().NoError(err)
+		httpServer := httptest.NewServer(srv)
+		defer httpServer.Close()
+		conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(httpServer.URL, "http")+"/rooms/lunch/ws", nil)
+		suite.Require().NoError(err)
+		defer conn.Close()
+
+		for {
+			msg := suite.read(conn)
+			if msg.Type == server.MessageRoom && msg.Room.State == server.RoomOver {
+				suite.Contains(msg.Room.Error, server.ErrGamePanic.Error())
+				break
+			}
+			if msg.ID != 0 {
+				suite.Require().NoError(conn.WriteJSON(answer(msg)))
+			}
+		}
+		<-room.Done()
+		_, err = room.Result()
+		suite.ErrorIs(err, server.ErrGamePanic)
+	}
+}
+
+// helpers
+
+// panicker is an AlphaPlayer that panics when it is told the result of an Auction
+type panicker struct {
+	*players.AlphaPlayer
+}
+
+func (p *panicker) HandleAuctionResult(*game.Auction) {
+	panic("panicker panicked")
+}
+
+// wsURL returns the WebSocket URL of the Room with the query
+func (suite *ServerTestSuite) wsURL(room string, query string) string {
+	u, err := url.Parse(suite.httpServer.URL)
+	suite.Require().NoError(err)
+	u.Scheme = "ws"
+	u.Path = "/rooms/" + room + "/ws"
+	u.RawQuery = query
+	return u.String()
+}
+
+// dial joins the Room over WebSocket
+func (suite *ServerTestSuite) dial(room string, query string) *websocket.Conn {
+	conn, _, err := websocket.DefaultDialer.Dial(suite.wsURL(room, query), nil)
+	suite.Require().NoError(err)
+	return conn
+}
+
+// readUntil reads Messages until one of the type
+func (suite *ServerTestSuite) readUntil(conn *websocket.Conn, messageType string) *server.Message {
+	for {
+		msg := suite.read(conn)
+		if msg.Type == messageType {
+			return msg
+		}
+	}
+}
+
+// readDecision reads Messages until a decision
+func (suite *ServerTestSuite) readDecision(conn *websocket.Conn) *server.Message {
+	for {
+		msg := suite.read(conn)
+		if msg.ID != 0 {
+			return msg
+		}
+	}
+}
+
+// read reads a Message, failing the test if none comes in time
+func (suite *ServerTestSuite) read(conn *websocket.Conn) *server.Message {
+	suite.Require().NoError(conn.SetReadDeadline(time.Now().Add(10 * time.Second)))
+	msg := &server.Message{}
+	suite.Require().NoError(conn.ReadJSON(msg))
+	return msg
+}
+
+// play answers every decision until the Game is over, and returns the player of each view it was sent
+func (suite *ServerTestSuite) play(conn *websocket.Conn, player string) []string {
+	defer conn.Close()
+	views := make([]string, 0)
+	for {
+		msg := suite.read(conn)
+		if msg.Type == players.MessageGameOver {
+			return views
+		}
+		if msg.ID == 0 {
+			continue
+		}
+		views = append(views, msg.View.Player)
+		if err := conn.WriteJSON(answer(msg)); err != nil {
+			return views
+		}
+	}
+}
+
+// answer answers a decision like a player who never buys: they auction their first card, bid nothing
+// and close or leave open Auctions
+func answer(msg *server.Message) *server.Reply {
+	reply := &server.Reply{ID: msg.ID}
+	switch msg.Type {
+	case players.MessageHoldAuction:
+		reply.ArtPiece = msg.View.Hand[0]
+	case players.MessageOpenBid:
+		reply.Move = game.OpenBidWithdraw
+		if msg.Auction.Auctioneer == msg.View.Player {
+			reply.Move = game.OpenBidClose
+		}
+	}
+	return reply
+}
@@ -0,0 +1,588 @@
+"use strict";
+
+// The browser client of the Server. It lists and creates Rooms, takes a seat over WebSocket and plays it.
+// The public state of the table is rebuilt from the Game's Events, and the seat's own hand and money from
+// the views of its decisions and its notifications.
+
+const ARTISTS = ["Manuel Carvalho", "Sigrid Thaler", "Daniel Melim", "Ramon Martins", "Rafael Silvera"];
+const POINTS_PER_ART_PIECE = 10;
+const DECISIONS = ["hold-auction", "offer-double", "set-price", "bid", "open-bid"];
+const GOING = ["", "going once", "going twice"];
+
+let table = null;
+let socket = null;
+
+// el creates an element with the attributes and children. Strings become text, never HTML.
+function el(tag, attrs = {}, ...children) {
+	const node = document.createElement(tag);
+	for (const [name, value] of Object.entries(attrs)) {
+		if (name.startsWith("on")) {
+			node.addEventListener(name.slice(2), value);
+		} else if (value !== false && value !== null && value !== undefined) {
+			node.setAttribute(name, value === true ? "" : value);
+		}
+	}
+	for (const child of children.flat(Infinity)) {
+		if (child !== null && child !== undefined) {
+			node.append(child instanceof Node ? child : String(child));
+		}
+	}
+	return node;
+}
+
+function $(selector) {
+	return document.querySelector(selector);
+}
+
+function setStatus(text) {
+	$("#status").textContent = text;
+}
+
+function tokenKey(room) {
+	return "modern-art/token/" + room;
+}
+
+function artistClass(artist) {
+	return "artist-" + artist.split(" ")[0].toLowerCase();
+}
+
+// auctionType returns the AuctionType an Auction is run with. A double is run with the type of its second ArtPiece.
+function auctionType(pieces) {
+	return pieces.length > 1 ? pieces[1].auction_type : pieces[0].auction_type;
+}
+
+function card(piece, attrs = {}, tag = "li") {
+	return el(tag, Object.assign({class: "card " + artistClass(piece.artist)}, attrs),
+		piece.artist, el("small", {}, piece.auction_type));
+}
+
+function money(amount) {
+	return "$" + amount;
+}
+
+// Lobby
+
+async function loadRooms() {
+	const rooms = await (await fetch("rooms")).json();
+	const body = $("#rooms tbody");
+	body.replaceChildren();
+	for (const room of rooms) {
+		const taken = room.seats.filter((seat) => seat.player).length;
+		const seats = room.seats.map((seat) => seat.player || "—").join(", ");
+		let action = null;
+		if (localStorage.getItem(tokenKey(room.name)) && room.state !== "over") {
+			action = el("button", {onclick: () => rejoin(room.name)}, "Return");
+		} else if (room.state === "waiting" && taken < room.seats.length) {
+			action = el("button", {onclick: () => join(room.name)}, "Join");
+		}
+		body.append(el("tr", {}, el("td", {}, room.name), el("td", {}, room.state),
+			el("td", {}, `${taken}/${room.seats.length}: ${seats}`), el("td", {}, action)));
+	}
+}
+
+async function loadBots() {
+	const bots = await (await fetch("bots")).json();
+	$("#bots").replaceChildren(...bots.map((kind) =>
+		el("label", {}, el("input", {type: "number", min: 0, max: 5, value: 0, "data-bot": kind}), " " + kind)));
+}
+
+async function createRoom(event) {
+	event.preventDefault();
+	const form = event.target;
+	const bots = [];
+	for (const input of form.querySelectorAll("[data-bot]")) {
+		for (let i = 0; i < Number(input.value); i++) {
+			bots.push(input.dataset.bot);
+		}
+	}
+	const config = {
+		name: form.name.value,
+		players: Number(form.players.value),
+		bots: bots,
+		decision_timeout: Number(form.decision_timeout.value),
+	};
+	const resp = await fetch("rooms", {method: "POST", body: JSON.stringify(config)});
+	if (!resp.ok) {
+		setStatus((await resp.json()).error);
+		return;
+	}
+	setStatus("");
+	await loadRooms();
+	join(config.name);
+}
+
+function join(room) {
+	const form = $("#join");
+	if (!form.reportValidity()) {
+		return;
+	}
+	localStorage.setItem("modern-art/player", form.player.value);
+	connect(room, "player=" + encodeURIComponent(form.player.value));
+}
+
+function rejoin(room) {
+	connect(room, "token=" + encodeURIComponent(localStorage.getItem(tokenKey(room))));
+}
+
+function showLobby() {
+	table = null;
+	location.hash = "";
+	$("#table").hidden = true;
+	$("#lobby").hidden = false;
+	loadRooms();
+}
+
+// Table
+
+function newTable(room, player) {
+	return {
+		room: {name: room, state: "playing", seats: []},
+		me: player,
+		rules: null,
+		players: [],
+		hand: [],
+		money: null,
+		// playerMoney is only known if the rules make money open
+		playerMoney: null,
+		counts: {},
+		collections: {},
+		phases: [],
+		auction: null,
+		decision: null,
+		// shown is what the decision panel shows, so that it is not drawn again while the player fills it in
+		shown: null,
+		result: null,
+		log: [],
+	};
+}
+
+function connect(room, query) {
+	if (socket) {
+		socket.onclose = null;
+		socket.close();
+	}
+	const scheme = location.protocol === "https:" ? "wss:" : "ws:";
+	const url = `${scheme}//${location.host}${location.pathname.replace(/[^/]*$/, "")}rooms/${encodeURIComponent(room)}/ws?${query}`;
+	const ws = new WebSocket(url);
+	let joined = false;
+	socket = ws;
+	setStatus("connecting to " + room);
+	ws.onmessage = (event) => {
+		if (socket !== ws) {
+			return;
+		}
+		const msg = JSON.parse(event.data);
+		if (msg.type === "joined") {
+			joined = true;
+		}
+		handle(msg);
+	};
+	ws.onclose = () => {
+		if (socket !== ws) {
+			return;
+		}
+		socket = null;
+		if (!joined) {
+			// the seat could not be taken; a token that no longer works is forgotten
+			if (query.startsWith("token=")) {
+				localStorage.removeItem(tokenKey(room));
+			}
+			setStatus("could not join " + room);
+			showLobby();
+			return;
+		}
+		if (table && table.room.state !== "over") {
+			setStatus("disconnected, reconnecting…");
+			setTimeout(() => table && rejoin(room), 2000);
+		}
+	};
+}
+
+function send(reply) {
+	socket.send(JSON.stringify(Object.assign({id: table.decision.id}, reply)));
+	table.decision = null;
+	render();
+}
+
+function handle(msg) {
+	switch (msg.type) {
+	case "joined":
+		table = newTable(msg.room.name, msg.seat.player);
+		localStorage.setItem(tokenKey(msg.room.name), msg.token);
+		location.hash = encodeURIComponent(msg.room.name);
+		(msg.events || []).forEach(applyEvent);
+		$("#lobby").hidden = true;
+		$("#table").hidden = false;
+		setStatus(`playing as ${msg.seat.player}`);
+		break;
+	case "room":
+		table.room = msg.room;
+		if (msg.room.result) {
+			table.result = msg.room.result;
+		}
+		break;
+	case "event":
+		applyEvent(msg.event);
+		break;
+	case "add-art-pieces":
+		table.hand.push(...(msg.art_pieces || []));
+		break;
+	case "move-money":
+		if (table.money !== null) {
+			table.money += msg.amount;
+		}
+		break;
+	case "game-over":
+		table.result = msg.result;
+		break;
+	default:
+		if (DECISIONS.includes(msg.type)) {
+			table.decision = msg;
+			applyView(msg.view);
+		}
+	}
+	render();
+}
+
+// applyView takes the seat's own state from the view of a decision
+function applyView(view) {
+	table.hand = view.hand;
+	table.money = view.money;
+	if (view.player_money) {
+		table.playerMoney = view.player_money;
+	}
+}
+
+function addMoney(player, amount) {
+	if (table.playerMoney && player) {
+		table.playerMoney[player] = (table.playerMoney[player] || 0) + amount;
+	}
+}
+
+function applyEvent(event) {
+	const log = (text) => table.log.push(text);
+	const auction = table.auction;
+	switch (event.type) {
+	case "game-start":
+		table.players = event.game_start.players;
+		table.rules = event.game_start.rules;
+		table.collections = {};
+		if (table.rules && table.rules.open_money) {
+			table.playerMoney = {};
+		}
+		log(`The game starts with ${table.players.join(", ")}`);
+		break;
+	case "turn-skipped":
+		log(`${event.turn_skipped.player} skips their turn`);
+		break;
+	case "timeout":
+		log(`${event.timeout.player} ran out of time to ${event.timeout.decision}`);
+		break;
+	case "auction-start": {
+		const start = event.auction_start;
+		const pieces = [start.art_piece, start.second_art_piece].filter((piece) => piece);
+		const names = pieces.map((piece) => piece.name);
+		table.hand = table.hand.filter((piece) => !names.includes(piece.name));
+		for (const piece of pieces) {
+			table.counts[piece.artist] = (table.counts[piece.artist] || 0) + POINTS_PER_ART_PIECE;
+		}
+		table.auction = {auctioneer: start.auctioneer, type: auctionType(pieces), pieces: pieces, standing: null, price: null, feed: []};
+		const by = start.double_played_by ? ` (double played by ${start.double_played_by})` : "";
+		log(`${start.auctioneer} auctions ${pieces.map((piece) => piece.artist).join(" + ")} (${table.auction.type})${by}`);
+		break;
+	}
+	case "set-price":
+		auction.price = event.bid.value;
+		auction.feed.push(`${event.bid.bidder} sets the price at ${money(event.bid.value)}`);
+		break;
+	case "bid":
+		if (auction.type === "set-price") {
+			auction.feed.push(`${event.bid.bidder} ${event.bid.winning ? "buys" : "declines"}`);
+		} else {
+			auction.feed.push(`${event.bid.bidder} bids ${money(event.bid.value)}`);
+		}
+		if (event.bid.winning) {
+			auction.standing = {bidder: event.bid.bidder, value: event.bid.value};
+		}
+		break;
+	case "pass":
+		auction.feed.push(`${event.bid.bidder} ${auction.type === "set-price" && event.bid.bidder !== auction.auctioneer ? "declines" : "passes"}`);
+		break;
+	case "withdraw":
+		auction.feed.push(`${event.bid.bidder} withdraws`);
+		break;
+	case "close":
+		auction.feed.push(`${event.bid.bidder} closes the auction at ${money(event.bid.value)}`);
+		break;
+	case "auction-result": {
+		const result = event.auction_result;
+		const artists = result.art_pieces.map((piece) => piece.artist).join(" + ");
+		if (result.ended_phase) {
+			log(`${artists} ends the phase`);
+		} else {
+			(table.collections[result.buyer] = table.collections[result.buyer] || []).push(...result.art_pieces);
+			log(`${result.buyer} buys ${artists} from ${result.auctioneer} for ${money(result.price)}`);
+		}
+		table.auction = null;
+		break;
+	}
+	case "transfer":
+		addMoney(event.transfer.from, -event.transfer.amount);
+		addMoney(event.transfer.to, event.transfer.amount);
+		break;
+	case "phase-end": {
+		const end = event.phase_end;
+		table.phases.push({rankings: end.rankings, counts: end.artist_counts});
+		table.counts = {};
+		table.collections = {};
+		if (end.money) {
+			table.playerMoney = end.money;
+		}
+		const payouts = Object.entries(end.payouts || {}).map(([player, amount]) => `${player} ${money(amount)}`);
+		log(`Phase ${table.phases.length} ends: ${end.rankings.slice(0, 3).join(", ")}. Paid: ${payouts.join(", ") || "nobody"}`);
+		break;
+	}
+	case "game-end":
+		log("The game is over");
+		break;
+	}
+}
+
+// phaseTile returns what one ArtPiece of the artist was worth in the Phase
+function phaseTile(phase, artist) {
+	const payouts = (table.rules && table.rules.rank_payouts) || [30, 20, 10];
+	const rank = phase.rankings.indexOf(artist);
+	if (rank < 0 || rank >= payouts.length || (phase.counts[artist] || 0) < POINTS_PER_ART_PIECE) {
+		return 0;
+	}
+	return payouts[rank];
+}
+
+// Rendering
+
+function render() {
+	if (!table) {
+		return;
+	}
+	$("#room-name").textContent = `${table.room.name} (${table.room.state})`;
+	renderPlayers();
+	renderBoard();
+	renderAuction();
+	renderDecision();
+	renderHand();
+	$("#log ol").replaceChildren(...table.log.slice().reverse().map((text) => el("li", {}, text)));
+}
+
+function renderPlayers() {
+	const seats = table.players.length ? table.players : table.room.seats.map((seat) => seat.player).filter((p) => p);
+	const connected = Object.fromEntries(table.room.seats.map((seat) => [seat.player, seat.connected]));
+	$("#players tbody").replaceChildren(...seats.map((player) => {
+		let cash = "?";
+		if (player === table.me && table.money !== null) {
+			cash = money(table.money);
+		} else if (table.playerMoney && player in table.playerMoney) {
+			cash = money(table.playerMoney[player]);
+		}
+		const name = connected[player] === false ? `${player} (away)` : player;
+		const collection = (table.collections[player] || []).map((piece) =>
+			el("span", {class: "dot " + artistClass(piece.artist), title: piece.artist}));
+		return el("tr", {class: player === table.me ? "me" : null}, el("td", {}, name), el("td", {}, cash), el("td", {}, collection));
+	}));
+}
+
+function renderBoard() {
+	const phases = table.phases;
+	$("#board thead").replaceChildren(el("tr", {}, el("th", {}, "Artist"),
+		phases.map((_, i) => el("th", {}, `P${i + 1}`)), el("th", {}, "Value"), el("th", {}, "This phase")));
+	$("#board tbody").replaceChildren(...ARTISTS.map((artist) => {
+		const tiles = phases.map((phase) => phaseTile(phase, artist));
+		const value = tiles.reduce((sum, tile) => sum + tile, 0);
+		const count = (table.counts[artist] || 0) / POINTS_PER_ART_PIECE;
+		return el("tr", {}, el("td", {}, el("span", {class: "dot " + artistClass(artist)}), artist),
+			tiles.map((tile) => el("td", {}, tile ? money(tile) : "")), el("td", {}, money(value)), el("td", {}, count));
+	}));
+}
+
+function renderAuction() {
+	const auction = table.auction;
+	if (!auction) {
+		$("#auction-state").replaceChildren(el("p", {}, "No auction in progress"));
+		$("#auction-feed").replaceChildren();
+		return;
+	}
+	const lines = [el("p", {}, `${auction.auctioneer} runs a ${auction.type} auction`)];
+	lines.push(el("ul", {class: "cards"}, auction.pieces.map((piece) => card(piece))));
+	if (auction.price !== null) {
+		lines.push(el("p", {}, `Price: ${money(auction.price)}`));
+	} else if (auction.standing) {
+		lines.push(el("p", {}, `Standing bid: ${money(auction.standing.value)} by ${auction.standing.bidder}`));
+	} else if (auction.type === "blind") {
+		lines.push(el("p", {}, "Bids are secret until everyone has bid"));
+	}
+	$("#auction-state").replaceChildren(...lines);
+	$("#auction-feed").replaceChildren(...auction.feed.map((text) => el("li", {}, text)));
+}
+
+function renderHand() {
+	$("#money").textContent = table.money === null ? "" : `· ${money(table.money)}`;
+	$("#hand .cards").replaceChildren(...table.hand.map((piece) => card(piece)));
+}
+
+function renderDecision() {
+	const section = $("#decision");
+	const decision = table.decision;
+	section.classList.toggle("idle", !decision);
+	if (decision && decision === table.shown) {
+		return;
+	}
+	table.shown = decision;
+	if (table.result) {
+		$("#decision-title").textContent = "Game Over";
+		$("#decision-body").replaceChildren(standings(table.result));
+		return;
+	}
+	if (!decision) {
+		$("#decision-title").textContent = "Waiting";
+		$("#decision-body").replaceChildren(el("p", {}, table.room.state === "waiting" ? "Waiting for players to join" : "Waiting for the other players"));
+		return;
+	}
+	const forms = {
+		"hold-auction": holdAuctionForm,
+		"offer-double": offerDoubleForm,
+		"set-price": setPriceForm,
+		"bid": bidForm,
+		"open-bid": openBidForm,
+	};
+	forms[decision.type](decision);
+}
+
+function decide(title, ...body) {
+	$("#decision-title").textContent = title;
+	$("#decision-body").replaceChildren(...body.flat(Infinity).filter((node) => node !== null && node !== undefined));
+}
+
+// amountForm is a form for an amount of money up to the seat's money
+function amountForm(label, value, min, submit, onsubmit, ...buttons) {
+	const input = el("input", {type: "number", name: "amount", min: min, max: table.money, value: value, required: true});
+	const form = el("form", {onsubmit: (event) => {
+		event.preventDefault();
+		onsubmit(Number(input.value));
+	}}, el("label", {}, label + " ", input), el("button", {}, submit), buttons);
+	setTimeout(() => input.focus());
+	return form;
+}
+
+function holdAuctionForm(decision) {
+	let first = null;
+	const pick = (piece) => {
+		first = piece;
+		const seconds = decision.view.hand.filter((other) =>
+			other.name !== piece.name && other.artist === piece.artist && other.auction_type !== "double");
+		const choices = [];
+		if (piece.auction_type === "double" && seconds.length) {
+			choices.push(el("p", {}, "Add a second card to the double, or auction it alone for another player to add one:"));
+			choices.push(el("div", {class: "cards"}, seconds.map((second) =>
+				card(second, {onclick: () => send({art_piece: first, second_art_piece: second})}, "button"))));
+		}
+		choices.push(el("button", {onclick: () => send({art_piece: first})}, piece.auction_type === "double" && seconds.length ? "Auction it alone" : "Auction it"));
+		decide("Hold an auction", el("p", {}, "Your card:"), el("div", {class: "cards"}, card(piece, {}, "div")), choices,
+			el("button", {onclick: () => holdAuctionForm(decision)}, "Back"));
+	};
+	decide("Hold an auction", el("p", {}, "Choose a card to auction:"),
+		el("div", {class: "cards"}, decision.view.hand.map((piece) => card(piece, {onclick: () => pick(piece)}, "button"))));
+}
+
+function offerDoubleForm(decision) {
+	const double = decision.auction.art_piece;
+	const seconds = decision.view.hand.filter((piece) => piece.artist === double.artist && piece.auction_type !== "double");
+	decide("Add to a double", el("p", {}, `${decision.auction.auctioneer} plays a double ${double.artist}. Add a card to run the auction and take the money:`),
+		el("div", {class: "cards"}, seconds.map((piece) => card(piece, {onclick: () => send({art_piece: piece})}, "button"))),
+		el("button", {onclick: () => send({})}, "Decline"));
+}
+
+function setPriceForm(decision) {
+	const pieces = [decision.auction.art_piece, decision.auction.second_art_piece].filter((piece) => piece);
+	decide("Set a price", el("div", {class: "cards"}, pieces.map((piece) => card(piece, {}, "div"))),
+		el("p", {}, "If nobody buys at your price, you buy it yourself."),
+		amountForm("Price", 0, 0, "Set price", (price) => send({price: price})));
+}
+
+function bidForm(decision) {
+	const auction = decision.auction;
+	const pieces = [auction.art_piece, auction.second_art_piece].filter((piece) => piece);
+	const type = auctionType(pieces);
+	const shown = el("div", {class: "cards"}, pieces.map((piece) => card(piece, {}, "div")));
+	const standing = auction.winning_bid;
+	if (type === "set-price") {
+		const price = standing ? standing.value : 0;
+		decide("Buy at the price?", shown, el("p", {}, `${auction.auctioneer} asks ${money(price)}.`),
+			el("button", {disabled: table.money < price, onclick: () => send({bid: price})}, `Buy for ${money(price)}`),
+			el("button", {onclick: () => send({})}, "Pass"));
+		return;
+	}
+	if (type === "blind") {
+		decide("Bid secretly", shown, el("p", {}, "Everyone bids once without seeing the other bids."),
+			amountForm("Your bid", 0, 0, "Bid", (bid) => send({bid: bid})));
+		return;
+	}
+	const high = standing && standing.value > 0 ? `The highest bid is ${money(standing.value)} by ${standing.bidder}.` : "Nobody has bid yet.";
+	const min = standing ? standing.value + 1 : 1;
+	decide("Your bid", shown, el("p", {}, `You get one bid. ${high}`),
+		amountForm("Your bid", Math.min(min, table.money), 0, "Bid", (bid) => send({bid: bid}),
+			el("button", {type: "button", onclick: () => send({bid: 0})}, "Pass")));
+}
+
+function openBidForm(decision) {
+	const auction = decision.auction;
+	const standing = auction.winning_bid;
+	const going = GOING[decision.going] ? el("p", {class: "going"}, GOING[decision.going] + "!") : null;
+	const high = standing && standing.value > 0 ? `Standing bid: ${money(standing.value)} by ${standing.bidder}.` : "Nobody has bid yet.";
+	const min = standing ? standing.value + 1 : 1;
+	const buttons = [
+		el("button", {type: "button", onclick: () => send({move: "pass"})}, "Pass"),
+		el("button", {type: "button", onclick: () => send({move: "withdraw"})}, "Withdraw"),
+	];
+	if (auction.auctioneer === table.me) {
+		buttons.push(el("button", {type: "button", onclick: () => send({move: "close"})}, "Close the auction"));
+	}
+	if (standing && standing.bidder === table.me) {
+		decide("Open auction", el("p", {}, `You hold the standing bid of ${money(standing.value)}.`), going,
+			el("p", {}, "Close the auction to buy at your bid, or pass to let the others raise."), ...buttons);
+		return;
+	}
+	decide("Open auction", el("p", {}, high), going,
+		el("p", {}, "Raise the bid, pass this round, or withdraw for good."),
+		amountForm("Raise to", Math.min(min, table.money), min, "Raise", (bid) => send({move: "raise", bid: bid}), buttons));
+}
+
+function standings(result) {
+	return el("table", {}, el("thead", {}, el("tr", {}, el("th", {}, "Place"), el("th", {}, "Player"), el("th", {}, "Money"))),
+		el("tbody", {}, result.standings.map((standing) =>
+			el("tr", {class: standing.player === table.me ? "me" : null},
+				el("td", {}, standing.place + (standing.tied ? " (tied)" : "")),
+				el("td", {}, standing.player), el("td", {}, money(standing.money))))));
+}
+
+// Start
+
+$("#create").addEventListener("submit", createRoom);
+$("#join").player.value = localStorage.getItem("modern-art/player") || "";
+$("#leave").addEventListener("click", () => {
+	const ws = socket;
+	socket = null;
+	if (ws) {
+		ws.close();
+	}
+	showLobby();
+});
+loadBots();
+loadRooms();
+setInterval(() => {
+	if (!table) {
+		loadRooms();
+	}
+}, 3000);
+const room = decodeURIComponent(location.hash.slice(1));
+if (room && localStorage.getItem(tokenKey(room))) {
+	rejoin(room);
+}
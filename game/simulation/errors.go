@@ -0,0 +1,7 @@
+package simulation
+
+import "fmt"
+
+var (
+	ErrGamePanic = fmt.Errorf("game panicked")
+)
@@ -0,0 +1,148 @@
+package simulation
+
+import (
+	"context"
+	"fmt"
+	"github.com/SachinMeier/modern-art.git/game"
+	"io"
+	"runtime"
+	"sync"
+)
+
+// Job is one Game to simulate
+type Job struct {
+	// ID identifies the Job in its Outcome
+	ID int
+	// NewPlayers creates the Players of the Game. It is called by the worker that plays the Game and
+	// must return new Players every time, since Players keep state for the one Game they play.
+	// Players that implement io.Closer are closed once the Game is over.
+	NewPlayers func() []game.Player
+	// Options are the options of the Game, such as its seed and rules. They are shared by every
+	// Game they are given to, so they must not hold state of their own, like an EventListener would.
+	Options []game.GameOption
+}
+
+// Outcome is the result of a Job
+type Outcome struct {
+	// ID is the ID of the Job
+	ID     int
+	Result *game.GameResult
+	// Err is the error the Game stopped with. The Result is nil if it is set.
+	Err error
+}
+
+// Simulator plays Games on a pool of workers. Every Game is created from scratch by its worker,
+// so Games share no state and any number of them can run at once.
+type Simulator struct {
+	workers int
+}
+
+// NewSimulator creates a Simulator with the number of workers. A number below 1 uses one worker per CPU.
+func NewSimulator(workers int) *Simulator {
+	if workers < 1 {
+		workers = runtime.NumCPU()
+	}
+	return &Simulator{workers: workers}
+}
+
+// Workers returns the number of workers of the Simulator
+func (s *Simulator) Workers() int {
+	return s.workers
+}
+
+// Run plays the Jobs received on the channel and streams an Outcome for each one as soon as it finishes,
+// so Outcomes may arrive out of order. The Outcomes channel is closed once the Jobs channel is closed and
+// every Job is done. Cancelling the context stops the Games in progress and closes the channel early.
+func (s *Simulator) Run(ctx context.Context, jobs <-chan *Job) <-chan *Outcome {
+	outcomes := make(chan *Outcome, s.workers)
+	var wg sync.WaitGroup
+	for i := 0; i < s.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				var job *Job
+				select {
+				case <-ctx.Done():
+					return
+				case next, ok := <-jobs:
+					if !ok {
+						return
+					}
+					job = next
+				}
+				select {
+				case <-ctx.Done():
+					return
+				case outcomes <- play(ctx, job):
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(outcomes)
+	}()
+	return outcomes
+}
+
+// RunAll plays every Job and returns their Outcomes in the order of the Jobs. If the context
+// is cancelled, the Outcomes of the Jobs that did not finish hold the context's error.
+func (s *Simulator) RunAll(ctx context.Context, jobs []*Job) []*Outcome {
+	// the Jobs are queued with their index as ID, which matches each Outcome back to its Job
+	queue := make(chan *Job, len(jobs))
+	for i, job := range jobs {
+		queue <- &Job{ID: i, NewPlayers: job.NewPlayers, Options: job.Options}
+	}
+	close(queue)
+
+	outcomes := make([]*Outcome, len(jobs))
+	for outcome := range s.Run(ctx, queue) {
+		i := outcome.ID
+		outcome.ID = jobs[i].ID
+		outcomes[i] = outcome
+	}
+	for i, outcome := range outcomes {
+		if outcome == nil {
+			outcomes[i] = &Outcome{ID: jobs[i].ID, Err: ctx.Err()}
+		}
+	}
+	return outcomes
+}
+
+// play plays the Game of the Job. A Game that panics fails with ErrGamePanic, so the other Games go on.
+func play(ctx context.Context, job *Job) (outcome *Outcome) {
+	outcome = &Outcome{ID: job.ID}
+	var g *game.Game
+	defer func() {
+		if r := recover(); r != nil {
+			outcome.Result = nil
+			if g != nil {
+				outcome.Err = fmt.Errorf("%w: seed %d: %v", ErrGamePanic, g.Seed(), r)
+			} else {
+				outcome.Err = fmt.Errorf("%w: %v", ErrGamePanic, r)
+			}
+		}
+	}()
+	opts := append([]game.GameOption{}, job.Options...)
+	opts = append(opts, game.WithContext(ctx))
+	players := job.NewPlayers()
+	defer closePlayers(players)
+	g, err := game.NewGame(players, opts...)
+	if err != nil {
+		outcome.Err = err
+		return outcome
+	}
+	outcome.Result, outcome.Err = g.Start()
+	return outcome
+}
+
+// closePlayers closes the Players that hold resources, such as bot processes, once their Game is over.
+// The Game has been played by then, so errors closing them are ignored.
+func closePlayers(players []game.Player) {
+	for _, player := range players {
+		if closer, ok := player.(io.Closer); ok {
+			_ = closer.Close()
+		}
+	}
+}
@@ -0,0 +1,188 @@
+package simulation_test
+
+import (
+	"context"
+	"fmt"
+	"github.com/SachinMeier/modern-art.git/game"
+	"github.com/SachinMeier/modern-art.git/game/players"
+	"github.com/SachinMeier/modern-art.git/game/simulation"
+	"github.com/stretchr/testify/suite"
+	"sync"
+	"testing"
+)
+
+func TestSimulationSuite(t *testing.T) {
+	suite.Run(t, new(SimulationTestSuite))
+}
+
+type SimulationTestSuite struct {
+	suite.Suite
+	testCtx    context.Context
+	cancelFunc context.CancelFunc
+}
+
+func (suite *SimulationTestSuite) SetupSuite() {}
+
+func (suite *SimulationTestSuite) SetupTest() {
+	suite.testCtx, suite.cancelFunc = context.WithCancel(context.Background())
+}
+
+func (suite *SimulationTestSuite) TearDownTest() {
+	suite.cancelFunc()
+}
+
+func (suite *SimulationTestSuite) TearDownSuite() {}
+
+func (suite *SimulationTestSuite) Test_RunAll() {
+	// 1. Test that Games played at once have the results of the same Games played alone
+	{
+		jobs := suite.newJobs(64)
+		outcomes := simulation.NewSimulator(8).RunAll(suite.testCtx, jobs)
+		suite.Require().Equal(len(jobs), len(outcomes))
+		for i, outcome := range outcomes {
+			suite.Require().NoError(outcome.Err)
+			suite.Equal(jobs[i].ID, outcome.ID)
+
+			g, err := game.NewGame(jobs[i].NewPlayers(), jobs[i].Options...)
+			suite.Require().NoError(err)
+			result, err := g.Start()
+			suite.Require().NoError(err)
+			suite.Equal(result.Scores(), outcome.Result.Scores())
+		}
+	}
+
+	// 2. Test that a Game that fails does not stop the others
+	{
+		jobs := suite.newJobs(4)
+		jobs[1].NewPlayers = func() []game.Player { return suite.getNDummyPlayers(2) }
+		outcomes := simulation.NewSimulator(2).RunAll(suite.testCtx, jobs)
+		for i, outcome := range outcomes {
+			if i == 1 {
+				suite.ErrorIs(outcome.Err, game.ErrPlayerCount)
+				suite.Nil(outcome.Result)
+			} else {
+				suite.NoError(outcome.Err)
+			}
+		}
+	}
+
+	// 3. Test that a cancelled context stops the Games
+	{
+		ctx, cancel := context.WithCancel(suite.testCtx)
+		cancel()
+		outcomes := simulation.NewSimulator(2).RunAll(ctx, suite.newJobs(4))
+		for _, outcome := range outcomes {
+			suite.ErrorIs(outcome.Err, context.Canceled)
+		}
+	}
+
+	// 4. Test that Players that hold resources are closed once their Game is over
+	{
+		closers := make([]*closingPlayer, 0)
+		var mu sync.Mutex
+		jobs := suite.newJobs(3)
+		for _, job := range jobs {
+			job.NewPlayers = func() []game.Player {
+				closer := &closingPlayer{DummyPlayer: players.NewDummyPlayer("closer")}
+				mu.Lock()
+				closers = append(closers, closer)
+				mu.Unlock()
+				return append(suite.getNDummyPlayers(2), closer)
+			}
+		}
+		for _, outcome := range simulation.NewSimulator(2).RunAll(suite.testCtx, jobs) {
+			suite.NoError(outcome.Err)
+		}
+		suite.Equal(3, len(closers))
+		for _, closer := range closers {
+			suite.True(closer.closed)
+		}
+	}
+
+	// 5. Test that a Game that panics fails with its seed and does not stop the others
+	{
+		jobs := suite.newJobs(4)
+		jobs[2].NewPlayers = func() []game.Player {
+			return append(suite.getNDummyPlayers(2), &panickingPlayer{DummyPlayer: players.NewDummyPlayer("panicker")})
+		}
+		outcomes := simulation.NewSimulator(2).RunAll(suite.testCtx, jobs)
+		for i, outcome := range outcomes {
+			if i == 2 {
+				suite.ErrorIs(outcome.Err, simulation.ErrGamePanic)
+				suite.ErrorContains(outcome.Err, "seed 3")
+				suite.Nil(outcome.Result)
+			} else {
+				suite.NoError(outcome.Err)
+				suite.NotNil(outcome.Result)
+			}
+		}
+	}
+}
+
+func (suite *SimulationTestSuite) Test_Run() {
+	// 1. Test that every Job is streamed back once and the channel is closed
+	{
+		jobs := make(chan *simulation.Job)
+		go func() {
+			defer close(jobs)
+			for _, job := range suite.newJobs(32) {
+				jobs <- job
+			}
+		}()
+
+		seen := make(map[int]int)
+		for outcome := range simulation.NewSimulator(0).Run(suite.testCtx, jobs) {
+			suite.NoError(outcome.Err)
+			seen[outcome.ID]++
+		}
+		suite.Equal(32, len(seen))
+		for _, count := range seen {
+			suite.Equal(1, count)
+		}
+	}
+}
+
+// helpers
+
+// newJobs creates n seeded Games of AlphaPlayers and DummyPlayers
+func (suite *SimulationTestSuite) newJobs(n int) []*simulation.Job {
+	jobs := make([]*simulation.Job, n)
+	for i := 0; i < n; i++ {
+		jobs[i] = &simulation.Job{
+			ID: 100 + i,
+			NewPlayers: func() []game.Player {
+				return append(suite.getNDummyPlayers(2), players.NewAlphaPlayer("alpha-0"), players.NewAlphaPlayer("alpha-1"))
+			},
+			Options: []game.GameOption{game.WithSeed(int64(i + 1))},
+		}
+	}
+	return jobs
+}
+
+// closingPlayer records whether it was closed
+type closingPlayer struct {
+	*players.DummyPlayer
+	closed bool
+}
+
+func (p *closingPlayer) Close() error {
+	p.closed = true
+	return nil
+}
+
+// panickingPlayer panics when told the result of an Auction
+type panickingPlayer struct {
+	*players.DummyPlayer
+}
+
+func (p *panickingPlayer) HandleAuctionResult(auction *game.Auction) {
+	panic("panickingPlayer always panics")
+}
+
+func (suite *SimulationTestSuite) getNDummyPlayers(n int) []game.Player {
+	ps := make([]game.Player, n)
+	for i := 0; i < n; i++ {
+		ps[i] = players.NewDummyPlayer(fmt.Sprintf("dummy-%d", i))
+	}
+	return ps
+}
@@ -0,0 +1,323 @@
+package game
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+)
+
+// SnapshotVersion is the version of the Snapshot format written by this package.
+// It is increased whenever the format changes in a way older readers cannot handle,
+// once Snapshots have been released. Until then the format changes within version 1.
+const SnapshotVersion = 1
+
+// Snapshot is the full state of a Game between turns. It can be stored as JSON
+// and resumed with ResumeGame.
+type Snapshot struct {
+	Version      int              `json:"version"`
+	CurrentPhase PhaseNumber      `json:"current_phase"`
+	PastPhases   []*PhaseSnapshot `json:"past_phases"`
+	// Phase is the Phase in progress. It is nil between Phases.
+	Phase *PhaseSnapshot `json:"phase,omitempty"`
+	// SkippedTurns is the number of turns in a row in which no Auction was held
+	SkippedTurns int `json:"skipped_turns"`
+	// Players are in seat order, starting with the next auctioneer
+	Players []*PlayerSnapshot `json:"players"`
+	// ArtPieces is the Deck left to be dealt
+	ArtPieces         []*ArtPiece       `json:"art_pieces"`
+	MisbehaviorPolicy MisbehaviorPolicy `json:"misbehavior_policy"`
+	Rules             *RuleSet          `json:"rules"`
+	Seed              int64             `json:"seed"`
+	// RandDraws is the number of values the Game has drawn from its seed
+	RandDraws uint64 `json:"rand_draws"`
+	// Events are the Events the Game has emitted so far
+	Events []*Event `json:"events"`
+}
+
+// PhaseSnapshot is the state of a Phase
+type PhaseSnapshot struct {
+	Auctions     []*AuctionSnapshot `json:"auctions"`
+	ArtistCounts map[Artist]int     `json:"artist_counts"`
+}
+
+// AuctionSnapshot is an Auction with its Players replaced by their names
+type AuctionSnapshot struct {
+	Auctioneer     string      `json:"auctioneer"`
+	Type           AuctionType `json:"auction_type"`
+	ArtPiece       *ArtPiece   `json:"art_piece"`
+	SecondArtPiece *ArtPiece   `json:"second_art_piece,omitempty"`
+	// WinningBid is nil if the Auction ended the Phase
+	WinningBid *BidSnapshot `json:"winning_bid,omitempty"`
+	// BlindBids are the bids of a blind Auction
+	BlindBids []*BidSnapshot `json:"blind_bids,omitempty"`
+}
+
+// BidSnapshot is a Bid with its Bidder replaced by their name
+type BidSnapshot struct {
+	Bidder string `json:"bidder"`
+	Value  int    `json:"value"`
+}
+
+// PlayerSnapshot is the state of a GamePlayer
+type PlayerSnapshot struct {
+	Name       string      `json:"name"`
+	Hand       []*ArtPiece `json:"hand"`
+	Collection []*ArtPiece `json:"collection"`
+	Money      int         `json:"money"`
+	Seat       int         `json:"seat"`
+	Forfeited  bool        `json:"forfeited"`
+}
+
+// Snapshot returns the state of the Game. It must be taken between turns,
+// not from an EventListener or a Player while a turn is in progress.
+// Games created with WithRandSource cannot be snapshotted.
+func (g *Game) Snapshot() (*Snapshot, error) {
+	if g.source == nil {
+		return nil, ErrNoSeed
+	}
+	snapshot := &Snapshot{
+		Version:           SnapshotVersion,
+		CurrentPhase:      g.CurrentPhase,
+		PastPhases:        make([]*PhaseSnapshot, 0, len(g.PastPhases)),
+		SkippedTurns:      g.skippedTurns,
+		Players:           make([]*PlayerSnapshot, 0, len(g.Players)),
+		ArtPieces:         copyArtPieces(g.ArtPieces),
+		MisbehaviorPolicy: g.misbehaviorPolicy,
+		Rules:             g.rules.Copy(),
+		Seed:              g.seed,
+		RandDraws:         g.source.draws,
+		Events:            append([]*Event{}, g.events...),
+	}
+	for _, phase := range g.PastPhases {
+		snapshot.PastPhases = append(snapshot.PastPhases, newPhaseSnapshot(phase))
+	}
+	if g.phase != nil {
+		snapshot.Phase = newPhaseSnapshot(g.phase)
+	}
+	for _, player := range g.Players {
+		snapshot.Players = append(snapshot.Players, &PlayerSnapshot{
+			Name:       player.Player.Name(),
+			Hand:       copyArtPieces(player.Hand),
+			Collection: copyArtPieces(player.Collection),
+			Money:      player.Money,
+			Seat:       player.Seat,
+			Forfeited:  player.Forfeited,
+		})
+	}
+	return snapshot, nil
+}
+
+// copyArtPieces copies the slice so that a Snapshot and the Games resumed from it do not share state
+func copyArtPieces(artPieces []*ArtPiece) []*ArtPiece {
+	return append([]*ArtPiece{}, artPieces...)
+}
+
+func newPhaseSnapshot(phase *Phase) *PhaseSnapshot {
+	snapshot := &PhaseSnapshot{
+		Auctions:     make([]*AuctionSnapshot, 0, len(phase.Auctions)),
+		ArtistCounts: make(map[Artist]int),
+	}
+	for artist, count := range phase.ArtistCounts {
+		snapshot.ArtistCounts[artist] = count
+	}
+	for _, auction := range phase.Auctions {
+		snapshot.Auctions = append(snapshot.Auctions, NewAuctionSnapshot(auction))
+	}
+	return snapshot
+}
+
+// NewAuctionSnapshot returns the Auction with its Players replaced by their names
+func NewAuctionSnapshot(auction *Auction) *AuctionSnapshot {
+	snapshot := &AuctionSnapshot{
+		Auctioneer:     auction.Auctioneer.Name(),
+		Type:           auction.Type,
+		ArtPiece:       auction.ArtPiece,
+		SecondArtPiece: auction.SecondArtPiece,
+		WinningBid:     newBidSnapshot(auction.WinningBid),
+	}
+	for _, bid := range auction.BlindBids {
+		snapshot.BlindBids = append(snapshot.BlindBids, newBidSnapshot(bid))
+	}
+	return snapshot
+}
+
+func newBidSnapshot(bid *Bid) *BidSnapshot {
+	if bid == nil {
+		return nil
+	}
+	snapshot := &BidSnapshot{Value: bid.Value}
+	if bid.Bidder != nil {
+		snapshot.Bidder = bid.Bidder.Name()
+	}
+	return snapshot
+}
+
+// ResumeGame rebuilds a Game from a Snapshot. The players must have the names of the
+// Snapshot's players, in any order. They are given their state back through the Player
+// interface: MoveMoney with their money, AddArtPieces with their hand, and
+// HandleAuctionResult with every Auction they were told about.
+// RandPlayers get new sources derived from the Snapshot, so resuming the same
+// Snapshot twice plays the same Game. The Game keeps the RuleSet of the Snapshot.
+func ResumeGame(snapshot *Snapshot, players []Player, opts ...GameOption) (*Game, error) {
+	if snapshot.Version != SnapshotVersion {
+		return nil, fmt.Errorf("%w: %d, want %d", ErrSnapshotVersion, snapshot.Version, SnapshotVersion)
+	}
+	rules := DefaultRuleSet()
+	if snapshot.Rules != nil {
+		rules = snapshot.Rules.Copy()
+	}
+	if err := rules.Validate(); err != nil {
+		return nil, err
+	}
+	if rules.DummyCollector {
+		players = append(append([]Player{}, players...), newCollector())
+	}
+	if len(players) != len(snapshot.Players) {
+		return nil, fmt.Errorf("%w: got %d players, want %d", ErrSnapshotPlayers, len(players), len(snapshot.Players))
+	}
+	byName := make(map[string]Player)
+	for _, player := range players {
+		byName[player.Name()] = player
+	}
+
+	playerOrder := make(PlayerOrder, 0, len(snapshot.Players))
+	for _, ps := range snapshot.Players {
+		player, ok := byName[ps.Name]
+		if !ok {
+			return nil, fmt.Errorf("%w: missing player %s", ErrSnapshotPlayers, ps.Name)
+		}
+		playerOrder = append(playerOrder, &GamePlayer{
+			Player:     player,
+			Hand:       copyArtPieces(ps.Hand),
+			Collection: copyArtPieces(ps.Collection),
+			Money:      ps.Money,
+			Seat:       ps.Seat,
+			Forfeited:  ps.Forfeited,
+		})
+	}
+
+	source := newCountingSource(snapshot.Seed, snapshot.RandDraws)
+	g := &Game{
+		CurrentPhase:      snapshot.CurrentPhase,
+		PastPhases:        make([]*Phase, 0, len(snapshot.PastPhases)),
+		Players:           playerOrder,
+		ArtPieces:         copyArtPieces(snapshot.ArtPieces),
+		skippedTurns:      snapshot.SkippedTurns,
+		misbehaviorPolicy: snapshot.MisbehaviorPolicy,
+		rules:             rules,
+		seed:              snapshot.Seed,
+		source:            source,
+		rand:              rand.New(source),
+		events:            append([]*Event{}, snapshot.Events...),
+	}
+	for _, ps := range snapshot.PastPhases {
+		phase, err := g.restorePhase(ps)
+		if err != nil {
+			return nil, err
+		}
+		g.PastPhases = append(g.PastPhases, phase)
+	}
+	if snapshot.Phase != nil {
+		phase, err := g.restorePhase(snapshot.Phase)
+		if err != nil {
+			return nil, err
+		}
+		g.phase = phase
+	}
+	for _, opt := range opts {
+		opt(g)
+	}
+	// the Phases were restored with the Snapshot's rules
+	g.rules = rules
+
+	// RandPlayers get sources from a rand of their own, so the Game's rand is left as it was
+	playerRand := rand.New(rand.NewSource(snapshot.Seed ^ int64(snapshot.RandDraws)))
+	for _, player := range g.Players {
+		playerSeed := playerRand.Int63()
+		if randPlayer, ok := player.Player.(RandPlayer); ok {
+			randPlayer.SetRand(rand.New(rand.NewSource(playerSeed)))
+		}
+	}
+
+	g.restorePlayers()
+	return g, nil
+}
+
+// restorePhase rebuilds a Phase, looking up the Players of its Auctions
+func (g *Game) restorePhase(snapshot *PhaseSnapshot) (*Phase, error) {
+	phase := g.rules.NewPhase()
+	for artist, count := range snapshot.ArtistCounts {
+		phase.ArtistCounts[artist] = count
+	}
+	for _, as := range snapshot.Auctions {
+		auctioneer := g.LookupGamePlayer(as.Auctioneer)
+		if auctioneer == nil {
+			return nil, fmt.Errorf("%w: unknown auctioneer %s", ErrSnapshotPlayers, as.Auctioneer)
+		}
+		auction := &Auction{
+			Auctioneer:     auctioneer.Player,
+			Type:           as.Type,
+			ArtPiece:       as.ArtPiece,
+			SecondArtPiece: as.SecondArtPiece,
+		}
+		if as.WinningBid != nil {
+			bidder := g.LookupGamePlayer(as.WinningBid.Bidder)
+			if bidder == nil {
+				return nil, fmt.Errorf("%w: unknown bidder %s", ErrSnapshotPlayers, as.WinningBid.Bidder)
+			}
+			auction.WinningBid = NewBid(bidder.Player, as.WinningBid.Value)
+		}
+		for _, bs := range as.BlindBids {
+			bidder := g.LookupGamePlayer(bs.Bidder)
+			if bidder == nil {
+				return nil, fmt.Errorf("%w: unknown bidder %s", ErrSnapshotPlayers, bs.Bidder)
+			}
+			auction.BlindBids = append(auction.BlindBids, NewBid(bidder.Player, bs.Value))
+		}
+		phase.Auctions = append(phase.Auctions, auction)
+	}
+	return phase, nil
+}
+
+// restorePlayers gives the Players their state back
+func (g *Game) restorePlayers() {
+	for _, player := range g.Players {
+		player.Player.MoveMoney(player.Money)
+		if len(player.Hand) > 0 {
+			player.Player.AddArtPieces(copyArtPieces(player.Hand))
+		}
+	}
+	phases := g.PastPhases
+	if g.phase != nil {
+		phases = append(phases[:len(phases):len(phases)], g.phase)
+	}
+	for _, phase := range phases {
+		for _, auction := range phase.Auctions {
+			// an Auction that ended its Phase was never sold, so Players were not told about it
+			if auction.WinningBid == nil {
+				continue
+			}
+			for _, player := range g.Players {
+				player.Player.HandleAuctionResult(g.publicAuction(auction))
+			}
+		}
+	}
+}
+
+// WriteSnapshot writes the Snapshot to w as JSON
+func WriteSnapshot(w io.Writer, snapshot *Snapshot) error {
+	return json.NewEncoder(w).Encode(snapshot)
+}
+
+// ReadSnapshot reads a Snapshot written by WriteSnapshot and checks its version
+func ReadSnapshot(r io.Reader) (*Snapshot, error) {
+	snapshot := &Snapshot{}
+	if err := json.NewDecoder(r).Decode(snapshot); err != nil {
+		return nil, err
+	}
+	if snapshot.Version != SnapshotVersion {
+		return nil, fmt.Errorf("%w: %d, want %d", ErrSnapshotVersion, snapshot.Version, SnapshotVersion)
+	}
+	return snapshot, nil
+}
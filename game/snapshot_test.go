@@ -0,0 +1,274 @@
+package game_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/SachinMeier/modern-art.git/game"
+	"github.com/SachinMeier/modern-art.git/game/players"
+	"github.com/stretchr/testify/suite"
+	"math/rand"
+	"testing"
+)
+
+func TestSnapshotSuite(t *testing.T) {
+	suite.Run(t, new(SnapshotTestSuite))
+}
+
+type SnapshotTestSuite struct {
+	suite.Suite
+	testCtx    context.Context
+	cancelFunc context.CancelFunc
+}
+
+func (suite *SnapshotTestSuite) SetupSuite() {}
+
+func (suite *SnapshotTestSuite) SetupTest() {
+	suite.testCtx, suite.cancelFunc = context.WithCancel(context.Background())
+}
+
+func (suite *SnapshotTestSuite) TearDownTest() {
+	suite.cancelFunc()
+}
+
+func (suite *SnapshotTestSuite) TearDownSuite() {}
+
+func (suite *SnapshotTestSuite) Test_Resume() {
+	// 1. Test that a Game resumed mid-phase has the state of the snapshotted Game
+	{
+		ng := suite.newGame()
+		suite.doTurns(ng, game.Phase2, 3)
+		snapshot, err := ng.Snapshot()
+		suite.NoError(err)
+		suite.NotNil(snapshot.Phase)
+
+		resumed, err := game.ResumeGame(snapshot, suite.getNDummyPlayers(4))
+		suite.NoError(err)
+		suite.mustMatchGames(ng, resumed)
+	}
+
+	// 2. Test that a Game resumed from JSON has the state of the snapshotted Game
+	{
+		ng := suite.newGame()
+		suite.doTurns(ng, game.Phase3, 2)
+		snapshot, err := ng.Snapshot()
+		suite.NoError(err)
+
+		var buf bytes.Buffer
+		suite.NoError(game.WriteSnapshot(&buf, snapshot))
+		read, err := game.ReadSnapshot(&buf)
+		suite.NoError(err)
+
+		resumed, err := game.ResumeGame(read, suite.getNDummyPlayers(4))
+		suite.NoError(err)
+		suite.mustMatchGames(ng, resumed)
+	}
+
+	// 3. Test that players are given their state back
+	{
+		ng := suite.newGame()
+		suite.doTurns(ng, game.Phase2, 3)
+		snapshot, err := ng.Snapshot()
+		suite.NoError(err)
+
+		ps := make([]game.Player, 4)
+		for i := range ps {
+			ps[i] = &handPlayer{DummyPlayer: players.NewDummyPlayer(fmt.Sprintf("dummy-%d", i))}
+		}
+		resumed, err := game.ResumeGame(snapshot, ps)
+		suite.NoError(err)
+		for _, player := range ps {
+			p := player.(*handPlayer)
+			gp := resumed.LookupGamePlayer(p.Name())
+			suite.Equal(gp.Money, p.Money)
+			suite.Equal(handNames(gp), handNames(&game.GamePlayer{Hand: p.hand}))
+		}
+	}
+
+	// 4. Test that resuming the same snapshot twice plays the same Game to the end
+	{
+		ng := suite.newGame()
+		suite.doTurns(ng, game.Phase2, 3)
+		snapshot, err := ng.Snapshot()
+		suite.NoError(err)
+
+		resumed1, err := game.ResumeGame(snapshot, suite.getNDummyPlayers(4))
+		suite.NoError(err)
+		scores1, err := resumed1.Start()
+		suite.NoError(err)
+		resumed2, err := game.ResumeGame(snapshot, suite.getNDummyPlayers(4))
+		suite.NoError(err)
+		scores2, err := resumed2.Start()
+		suite.NoError(err)
+		suite.Equal(scores1, scores2)
+		suite.Equal(len(game.AllPhases()), len(resumed1.PastPhases))
+
+		// the event log continues where the snapshot left off
+		events := resumed1.Events()
+		suite.Equal(snapshot.Events, events[:len(snapshot.Events)])
+		for i, event := range events {
+			suite.Equal(i, event.Seq)
+		}
+	}
+
+	// 5. Test that a Game snapshotted between phases resumes with the next phase
+	{
+		ng := suite.newGame()
+		_, err := ng.DoPhase()
+		suite.NoError(err)
+		snapshot, err := ng.Snapshot()
+		suite.NoError(err)
+		suite.Nil(snapshot.Phase)
+
+		resumed, err := game.ResumeGame(snapshot, suite.getNDummyPlayers(4))
+		suite.NoError(err)
+		_, err = resumed.DoPhase()
+		suite.NoError(err)
+		suite.Equal(2, len(resumed.PastPhases))
+		suite.Equal(game.Phase3, resumed.CurrentPhase)
+		// the resumed Game deals the same cards as the original
+		_, err = ng.DoPhase()
+		suite.NoError(err)
+		suite.Equal(ng.PastPhases[1].ArtistCounts, resumed.PastPhases[1].ArtistCounts)
+		suite.Equal(len(ng.ArtPieces), len(resumed.ArtPieces))
+	}
+
+	// 6. Test that the bids of blind Auctions are kept
+	{
+		ng := suite.newGame()
+		ng.ArtPieces = newDeck(game.Manuel, game.AuctionTypeBlind, 40)
+		suite.doTurns(ng, game.Phase1, 2)
+		snapshot, err := ng.Snapshot()
+		suite.NoError(err)
+
+		resumed, err := game.ResumeGame(snapshot, suite.getNDummyPlayers(4))
+		suite.NoError(err)
+		expected := ng.View("dummy-0").Phase().Auctions
+		actual := resumed.View("dummy-0").Phase().Auctions
+		suite.Require().Equal(len(expected), len(actual))
+		for i, auction := range expected {
+			suite.Require().Equal(4, len(auction.BlindBids))
+			suite.Require().Equal(len(auction.BlindBids), len(actual[i].BlindBids))
+			for j, bid := range auction.BlindBids {
+				mustMatchBids(&suite.Suite, bid, actual[i].BlindBids[j])
+			}
+		}
+	}
+}
+
+func (suite *SnapshotTestSuite) Test_Errors() {
+	// 1. Test that an unknown or missing version is rejected
+	{
+		for _, version := range []int{game.SnapshotVersion + 1, 0} {
+			snapshot, err := suite.newGame().Snapshot()
+			suite.NoError(err)
+			suite.Equal(1, snapshot.Version)
+			snapshot.Version = version
+
+			_, err = game.ResumeGame(snapshot, suite.getNDummyPlayers(4))
+			suite.ErrorIs(err, game.ErrSnapshotVersion)
+			var buf bytes.Buffer
+			suite.NoError(game.WriteSnapshot(&buf, snapshot))
+			_, err = game.ReadSnapshot(&buf)
+			suite.ErrorIs(err, game.ErrSnapshotVersion)
+		}
+	}
+
+	// 2. Test that players must match the snapshot
+	{
+		snapshot, err := suite.newGame().Snapshot()
+		suite.NoError(err)
+
+		_, err = game.ResumeGame(snapshot, suite.getNDummyPlayers(3))
+		suite.ErrorIs(err, game.ErrSnapshotPlayers)
+		ps := suite.getNDummyPlayers(4)
+		ps[0].(*players.DummyPlayer).SetName("stranger")
+		_, err = game.ResumeGame(snapshot, ps)
+		suite.ErrorIs(err, game.ErrSnapshotPlayers)
+	}
+
+	// 3. Test that a Game with a rand.Source cannot be snapshotted
+	{
+		ng := mustNewGame(&suite.Suite, suite.getNDummyPlayers(4), game.WithRandSource(rand.NewSource(1)))
+		_, err := ng.Snapshot()
+		suite.ErrorIs(err, game.ErrNoSeed)
+	}
+
+	// 4. Test that a Game that is over has no more turns
+	{
+		ng := suite.newGame()
+		_, err := ng.Start()
+		suite.NoError(err)
+		_, err = ng.DoTurn()
+		suite.ErrorIs(err, game.ErrGameOver)
+	}
+}
+
+// helpers
+
+// handPlayer is a DummyPlayer that keeps the ArtPieces it is given
+type handPlayer struct {
+	*players.DummyPlayer
+	hand []*game.ArtPiece
+}
+
+func (p *handPlayer) AddArtPieces(pieces []*game.ArtPiece) {
+	p.hand = append(p.hand, pieces...)
+}
+
+// newGame creates a seeded Game of DummyPlayers
+func (suite *SnapshotTestSuite) newGame() *game.Game {
+	return mustNewGame(&suite.Suite, suite.getNDummyPlayers(4), game.WithSeed(7))
+}
+
+// doTurns plays up to the phase, then does n turns of it, failing if they end it
+func (suite *SnapshotTestSuite) doTurns(ng *game.Game, phase game.PhaseNumber, n int) {
+	for ng.CurrentPhase < phase {
+		_, err := ng.DoPhase()
+		suite.Require().NoError(err)
+	}
+	for i := 0; i < n; i++ {
+		phaseOver, err := ng.DoTurn()
+		suite.Require().NoError(err)
+		suite.Require().False(phaseOver)
+	}
+}
+
+func (suite *SnapshotTestSuite) mustMatchGames(expected, actual *game.Game) {
+	suite.Equal(expected.CurrentPhase, actual.CurrentPhase)
+	suite.Equal(len(expected.PastPhases), len(actual.PastPhases))
+	for i, phase := range expected.PastPhases {
+		suite.Equal(phase.ArtistCounts, actual.PastPhases[i].ArtistCounts)
+		suite.Equal(phase.Len(), actual.PastPhases[i].Len())
+	}
+	suite.Equal(len(expected.ArtPieces), len(actual.ArtPieces))
+	for i, artPiece := range expected.ArtPieces {
+		mustMatchArtPiece(&suite.Suite, artPiece, actual.ArtPieces[i])
+	}
+	suite.Equal(len(expected.Players), len(actual.Players))
+	for i, player := range expected.Players {
+		other := actual.Players[i]
+		suite.Equal(player.Player.Name(), other.Player.Name())
+		suite.Equal(player.Money, other.Money)
+		suite.Equal(player.Forfeited, other.Forfeited)
+		suite.Equal(handNames(player), handNames(other))
+		suite.Equal(len(player.Collection), len(other.Collection))
+	}
+	suite.Equal(expected.Seed(), actual.Seed())
+	suite.Equal(len(expected.Events()), len(actual.Events()))
+	snapshot1, err := expected.Snapshot()
+	suite.NoError(err)
+	snapshot2, err := actual.Snapshot()
+	suite.NoError(err)
+	suite.Equal(snapshot1.RandDraws, snapshot2.RandDraws)
+	suite.Equal(snapshot1.Phase, snapshot2.Phase)
+	suite.Equal(snapshot1.SkippedTurns, snapshot2.SkippedTurns)
+}
+
+func (suite *SnapshotTestSuite) getNDummyPlayers(n int) []game.Player {
+	ps := make([]game.Player, n)
+	for i := 0; i < n; i++ {
+		ps[i] = players.NewDummyPlayer(fmt.Sprintf("dummy-%d", i))
+	}
+	return ps
+}
@@ -0,0 +1,8 @@
+package tournament
+
+import "fmt"
+
+var (
+	ErrInvalidEntrant = fmt.Errorf("entrant needs a unique name and a factory")
+	ErrUnknownEntrant = fmt.Errorf("lineup names an unknown entrant")
+)
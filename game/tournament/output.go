@@ -0,0 +1,52 @@
+package tournament
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+)
+
+// csvHeader names the columns written by WriteCSV
+var csvHeader = []string{
+	"entrant", "games", "wins", "win_rate", "win_rate_low", "win_rate_high",
+	"average_money", "money_low", "money_high", "rating",
+}
+
+// WriteJSON writes the Result, including every Game, as JSON
+func WriteJSON(w io.Writer, result *Result) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(result)
+}
+
+// WriteCSV writes the Standings of the Result as CSV with a header row
+func WriteCSV(w io.Writer, result *Result) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, stats := range result.Standings {
+		row := []string{
+			stats.Entrant,
+			strconv.Itoa(stats.Games),
+			formatFloat(stats.Wins),
+			formatFloat(stats.WinRate),
+			formatFloat(stats.WinRateLow),
+			formatFloat(stats.WinRateHigh),
+			formatFloat(stats.AverageMoney),
+			formatFloat(stats.MoneyLow),
+			formatFloat(stats.MoneyHigh),
+			formatFloat(stats.Rating),
+		}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', 4, 64)
+}
@@ -0,0 +1,138 @@
+package tournament
+
+import (
+	"math"
+	"sort"
+)
+
+const (
+	// InitialRating is the rating of every Entrant before their first Game
+	InitialRating = 1500.0
+	// kFactor is the most an Elo rating can move in one Game
+	kFactor = 32.0
+	// z is the z-score of a 95% confidence interval
+	z = 1.96
+)
+
+// Stats are the results of an Entrant over all the seats they played
+type Stats struct {
+	Entrant string `json:"entrant"`
+	// Games is the number of seats the Entrant played. An Entrant with two seats at a table plays two Games.
+	Games int `json:"games"`
+	// Wins counts a first place shared by k seats as 1/k of a win
+	Wins    float64 `json:"wins"`
+	WinRate float64 `json:"win_rate"`
+	// WinRateLow and WinRateHigh bound the 95% Wilson confidence interval of the WinRate
+	WinRateLow   float64 `json:"win_rate_low"`
+	WinRateHigh  float64 `json:"win_rate_high"`
+	AverageMoney float64 `json:"average_money"`
+	// MoneyLow and MoneyHigh bound the 95% confidence interval of the AverageMoney
+	MoneyLow  float64 `json:"money_low"`
+	MoneyHigh float64 `json:"money_high"`
+	// Rating is a multiplayer Elo rating. Every Game counts as a match against each other Entrant at
+	// the table, ranked by money, with the K-factor split among the opponents.
+	Rating float64 `json:"rating"`
+}
+
+// computeStats returns the Stats of every Entrant in the Games, ordered by Rating
+func computeStats(games []*GameRecord) []*Stats {
+	byEntrant := make(map[string]*Stats)
+	money := make(map[string][]float64)
+	for _, record := range games {
+		winners := 0
+		for _, place := range record.Places {
+			if place == 1 {
+				winners++
+			}
+		}
+		for i, name := range record.Entrants {
+			stats := byEntrant[name]
+			if stats == nil {
+				stats = &Stats{Entrant: name, Rating: InitialRating}
+				byEntrant[name] = stats
+			}
+			stats.Games++
+			if record.Places[i] == 1 {
+				stats.Wins += 1 / float64(winners)
+			}
+			money[name] = append(money[name], float64(record.Money[i]))
+		}
+		updateRatings(byEntrant, record)
+	}
+
+	standings := make([]*Stats, 0, len(byEntrant))
+	for name, stats := range byEntrant {
+		stats.WinRate = stats.Wins / float64(stats.Games)
+		stats.WinRateLow, stats.WinRateHigh = wilsonInterval(stats.Wins, stats.Games)
+		stats.AverageMoney, stats.MoneyLow, stats.MoneyHigh = meanInterval(money[name])
+		standings = append(standings, stats)
+	}
+	sort.Slice(standings, func(i, j int) bool {
+		if standings[i].Rating != standings[j].Rating {
+			return standings[i].Rating > standings[j].Rating
+		}
+		return standings[i].Entrant < standings[j].Entrant
+	})
+	return standings
+}
+
+// updateRatings updates the Elo ratings of the Entrants of the Game. Every seat is compared with every
+// other seat, except seats of the same Entrant, and all changes are applied together.
+func updateRatings(byEntrant map[string]*Stats, record *GameRecord) {
+	n := len(record.Entrants)
+	if n < 2 {
+		return
+	}
+	changes := make(map[string]float64)
+	for i, name := range record.Entrants {
+		for j, other := range record.Entrants {
+			if name == other {
+				continue
+			}
+			expected := 1 / (1 + math.Pow(10, (byEntrant[other].Rating-byEntrant[name].Rating)/400))
+			score := 0.5
+			if record.Money[i] > record.Money[j] {
+				score = 1
+			} else if record.Money[i] < record.Money[j] {
+				score = 0
+			}
+			changes[name] += kFactor / float64(n-1) * (score - expected)
+		}
+	}
+	for name, change := range changes {
+		byEntrant[name].Rating += change
+	}
+}
+
+// wilsonInterval returns the 95% Wilson score interval of a rate of wins in n games
+func wilsonInterval(wins float64, n int) (float64, float64) {
+	if n == 0 {
+		return 0, 0
+	}
+	p := wins / float64(n)
+	denominator := 1 + z*z/float64(n)
+	center := (p + z*z/(2*float64(n))) / denominator
+	margin := z * math.Sqrt(p*(1-p)/float64(n)+z*z/(4*float64(n)*float64(n))) / denominator
+	return math.Max(0, center-margin), math.Min(1, center+margin)
+}
+
+// meanInterval returns the mean of the values and its 95% confidence interval
+func meanInterval(values []float64) (float64, float64, float64) {
+	if len(values) == 0 {
+		return 0, 0, 0
+	}
+	sum := 0.0
+	for _, value := range values {
+		sum += value
+	}
+	mean := sum / float64(len(values))
+	if len(values) < 2 {
+		return mean, mean, mean
+	}
+	squares := 0.0
+	for _, value := range values {
+		squares += (value - mean) * (value - mean)
+	}
+	stdErr := math.Sqrt(squares/float64(len(values)-1)) / math.Sqrt(float64(len(values)))
+	return mean, mean - z*stdErr, mean + z*stdErr
+}
@@ -0,0 +1,163 @@
+package tournament
+
+import (
+	"context"
+	"fmt"
+	"github.com/SachinMeier/modern-art.git/game"
+	"github.com/SachinMeier/modern-art.git/game/simulation"
+)
+
+// Factory creates a Player with the given name. The Tournament creates a new Player for every Game.
+type Factory func(name string) game.Player
+
+// Entrant is a Player taking part in a Tournament
+type Entrant struct {
+	// Name identifies the Entrant in lineups and results
+	Name string
+	New  Factory
+}
+
+// Config describes a Tournament
+type Config struct {
+	Entrants []Entrant
+	// Lineups are the tables to play, each listing an Entrant's name for every seat. An Entrant may
+	// take more than one seat. If there are no Lineups, all Entrants play at one table.
+	Lineups [][]string
+	// Games is the number of Games played for every rotation of the seats of each Lineup
+	Games int
+	// Seed is the seed of the first Game of each rotation. Game i is played with Seed+i, so every
+	// rotation of a Lineup is dealt the same cards by seat and every Entrant plays every seat's cards.
+	Seed int64
+	// Rules are the rules of every Game. Nil means the official rules.
+	Rules *game.RuleSet
+	// Options are added to the options of every Game. They are shared by Games played at once.
+	Options []game.GameOption
+	// Workers is the number of Games played at once. A number below 1 plays one Game per CPU.
+	// The Result does not depend on it.
+	Workers int
+}
+
+// Result is the outcome of a Tournament
+type Result struct {
+	// Standings are the statistics of each Entrant, ordered by Rating
+	Standings []*Stats `json:"standings"`
+	// Games are the Games in the order they were played
+	Games []*GameRecord `json:"games"`
+}
+
+// GameRecord is the outcome of one Game of a Tournament, by seat
+type GameRecord struct {
+	// Lineup is the index of the Lineup in the Config
+	Lineup int `json:"lineup"`
+	// Rotation is the number of seats the Lineup was rotated by
+	Rotation int   `json:"rotation"`
+	Seed     int64 `json:"seed"`
+	// Entrants are the names of the Entrants in each seat
+	Entrants []string `json:"entrants"`
+	Money    []int    `json:"money"`
+	Places   []int    `json:"places"`
+}
+
+// Run plays every Game of the Tournament and returns the Result, or the error of the first Game that failed
+func Run(config *Config) (*Result, error) {
+	factories := make(map[string]Factory)
+	for _, entrant := range config.Entrants {
+		if _, ok := factories[entrant.Name]; ok || entrant.New == nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidEntrant, entrant.Name)
+		}
+		factories[entrant.Name] = entrant.New
+	}
+	lineups := config.Lineups
+	if len(lineups) == 0 {
+		lineup := make([]string, 0, len(config.Entrants))
+		for _, entrant := range config.Entrants {
+			lineup = append(lineup, entrant.Name)
+		}
+		lineups = [][]string{lineup}
+	}
+	for _, lineup := range lineups {
+		for _, name := range lineup {
+			if _, ok := factories[name]; !ok {
+				return nil, fmt.Errorf("%w: %s", ErrUnknownEntrant, name)
+			}
+		}
+	}
+
+	jobs := make([]*simulation.Job, 0)
+	records := make([]*GameRecord, 0)
+	for i, lineup := range lineups {
+		for rotation := 0; rotation < len(lineup); rotation++ {
+			seats := rotate(lineup, rotation)
+			for j := 0; j < config.Games; j++ {
+				record := &GameRecord{
+					Lineup:   i,
+					Rotation: rotation,
+					Seed:     config.Seed + int64(j),
+					Entrants: seats,
+				}
+				jobs = append(jobs, newJob(config, factories, record, len(jobs)))
+				records = append(records, record)
+			}
+		}
+	}
+
+	// the Games are played at once, but their records are kept in order, since ratings depend on it
+	outcomes := simulation.NewSimulator(config.Workers).RunAll(context.Background(), jobs)
+	for i, outcome := range outcomes {
+		record := records[i]
+		if outcome.Err != nil {
+			return nil, fmt.Errorf("lineup %d, rotation %d, seed %d: %w", record.Lineup, record.Rotation, record.Seed, outcome.Err)
+		}
+		record.setResult(outcome.Result)
+	}
+	return &Result{Standings: computeStats(records), Games: records}, nil
+}
+
+// rotate returns the lineup with every Entrant moved the number of seats to the right
+func rotate(lineup []string, rotation int) []string {
+	seats := make([]string, len(lineup))
+	for i, name := range lineup {
+		seats[(i+rotation)%len(lineup)] = name
+	}
+	return seats
+}
+
+// newJob returns the Job of the Game of the record, with a new Player for every seat
+func newJob(config *Config, factories map[string]Factory, record *GameRecord, id int) *simulation.Job {
+	opts := []game.GameOption{game.WithSeed(record.Seed)}
+	if config.Rules != nil {
+		opts = append(opts, game.WithRuleSet(config.Rules))
+	}
+	opts = append(opts, config.Options...)
+	return &simulation.Job{
+		ID: id,
+		NewPlayers: func() []game.Player {
+			ps := make([]game.Player, len(record.Entrants))
+			for i, name := range record.Entrants {
+				// an Entrant can take several seats, so Players are named by seat
+				ps[i] = factories[name](playerName(name, i))
+			}
+			return ps
+		},
+		Options: opts,
+	}
+}
+
+// setResult records the money and place of every seat
+func (r *GameRecord) setResult(result *game.GameResult) {
+	r.Money = make([]int, len(r.Entrants))
+	r.Places = make([]int, len(r.Entrants))
+	for i, name := range r.Entrants {
+		for _, standing := range result.Standings {
+			if standing.Player == playerName(name, i) {
+				r.Money[i] = standing.Money
+				r.Places[i] = standing.Place
+			}
+		}
+	}
+}
+
+// playerName is the name of the Player of the Entrant in the seat
+func playerName(entrant string, seat int) string {
+	return fmt.Sprintf("%s-%d", entrant, seat)
+}
@@ -0,0 +1,150 @@
+package tournament_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"github.com/SachinMeier/modern-art.git/game"
+	"github.com/SachinMeier/modern-art.git/game/players"
+	"github.com/SachinMeier/modern-art.git/game/tournament"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+func TestTournamentSuite(t *testing.T) {
+	suite.Run(t, new(TournamentTestSuite))
+}
+
+type TournamentTestSuite struct {
+	suite.Suite
+	testCtx    context.Context
+	cancelFunc context.CancelFunc
+}
+
+func (suite *TournamentTestSuite) SetupSuite() {}
+
+func (suite *TournamentTestSuite) SetupTest() {
+	suite.testCtx, suite.cancelFunc = context.WithCancel(context.Background())
+}
+
+func (suite *TournamentTestSuite) TearDownTest() {
+	suite.cancelFunc()
+}
+
+func (suite *TournamentTestSuite) TearDownSuite() {}
+
+func (suite *TournamentTestSuite) Test_Run() {
+	// 1. Test that every rotation of every Lineup is played with the same seeds
+	{
+		config := suite.newConfig()
+		config.Lineups = [][]string{{"alpha", "dummy", "dummy"}, {"alpha", "alpha", "dummy", "dummy"}}
+		result, err := tournament.Run(config)
+		suite.Require().NoError(err)
+		suite.Require().Equal((3+4)*config.Games, len(result.Games))
+
+		record := result.Games[config.Games]
+		suite.Equal(0, record.Lineup)
+		suite.Equal(1, record.Rotation)
+		suite.Equal(config.Seed, record.Seed)
+		suite.Equal([]string{"dummy", "alpha", "dummy"}, record.Entrants)
+		for _, record := range result.Games {
+			suite.Equal(len(record.Entrants), len(record.Money))
+			suite.Equal(len(record.Entrants), len(record.Places))
+		}
+	}
+
+	// 2. Test that a Tournament with the same Config has the same Result
+	{
+		result1, err := tournament.Run(suite.newConfig())
+		suite.Require().NoError(err)
+		result2, err := tournament.Run(suite.newConfig())
+		suite.Require().NoError(err)
+		suite.Equal(result1, result2)
+	}
+
+	// 3. Test that the Stats add up
+	{
+		config := suite.newConfig()
+		result, err := tournament.Run(config)
+		suite.Require().NoError(err)
+		suite.Require().Equal(3, len(result.Standings))
+
+		wins, rating := 0.0, 0.0
+		for i, stats := range result.Standings {
+			suite.Equal(3*config.Games, stats.Games)
+			suite.InDelta(stats.Wins/float64(stats.Games), stats.WinRate, 1e-9)
+			suite.LessOrEqual(stats.WinRateLow, stats.WinRate)
+			suite.GreaterOrEqual(stats.WinRateHigh, stats.WinRate)
+			suite.LessOrEqual(stats.MoneyLow, stats.AverageMoney)
+			suite.GreaterOrEqual(stats.MoneyHigh, stats.AverageMoney)
+			if i > 0 {
+				suite.GreaterOrEqual(result.Standings[i-1].Rating, stats.Rating)
+			}
+			wins += stats.Wins
+			rating += stats.Rating
+		}
+		// every Game has one win to share and ratings only move between Entrants
+		suite.InDelta(float64(len(result.Games)), wins, 1e-9)
+		suite.InDelta(3*tournament.InitialRating, rating, 1e-6)
+	}
+
+	// 4. Test that a Lineup must name known Entrants that fit the rules
+	{
+		config := suite.newConfig()
+		config.Lineups = [][]string{{"alpha", "nobody", "dummy"}}
+		_, err := tournament.Run(config)
+		suite.ErrorIs(err, tournament.ErrUnknownEntrant)
+
+		config.Lineups = [][]string{{"alpha", "dummy"}}
+		_, err = tournament.Run(config)
+		suite.ErrorIs(err, game.ErrPlayerCount)
+
+		config.Entrants = append(config.Entrants, config.Entrants[0])
+		_, err = tournament.Run(config)
+		suite.ErrorIs(err, tournament.ErrInvalidEntrant)
+	}
+}
+
+func (suite *TournamentTestSuite) Test_Output() {
+	// 1. Test that the Result is written as JSON
+	{
+		result, err := tournament.Run(suite.newConfig())
+		suite.Require().NoError(err)
+		var buf bytes.Buffer
+		suite.Require().NoError(tournament.WriteJSON(&buf, result))
+
+		read := &tournament.Result{}
+		suite.Require().NoError(json.Unmarshal(buf.Bytes(), read))
+		suite.Equal(result, read)
+	}
+
+	// 2. Test that the Standings are written as CSV
+	{
+		result, err := tournament.Run(suite.newConfig())
+		suite.Require().NoError(err)
+		var buf bytes.Buffer
+		suite.Require().NoError(tournament.WriteCSV(&buf, result))
+
+		rows, err := csv.NewReader(&buf).ReadAll()
+		suite.Require().NoError(err)
+		suite.Require().Equal(1+len(result.Standings), len(rows))
+		suite.Equal("entrant", rows[0][0])
+		suite.Equal(result.Standings[0].Entrant, rows[1][0])
+	}
+}
+
+// helpers
+
+// newConfig returns a Tournament of AlphaPlayers and DummyPlayers at one table
+func (suite *TournamentTestSuite) newConfig() *tournament.Config {
+	return &tournament.Config{
+		Entrants: []tournament.Entrant{
+			{Name: "alpha", New: func(name string) game.Player { return players.NewAlphaPlayer(name) }},
+			{Name: "dummy", New: func(name string) game.Player { return players.NewDummyPlayer(name) }},
+			{Name: "dummy-b", New: func(name string) game.Player { return players.NewDummyPlayer(name) }},
+		},
+		Games: 4,
+		Seed:  7,
+	}
+}
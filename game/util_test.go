@@ -1,18 +1,39 @@
 package game_test
 
 import (
+	"fmt"
 	"github.com/SachinMeier/modern-art.git/game"
 	"github.com/stretchr/testify/suite"
 )
 
-func newArtPiece(artist game.Artist) game.ArtPiece {
+// officialRules are the rules Games are played with unless a test says otherwise
+var officialRules = game.DefaultRuleSet()
+
+func newArtPiece(artist game.Artist) *game.ArtPiece {
 	return game.NewArtPiece(artist, "test")
 }
 
 func newAuctionWithWinningBid(auctioneer game.Player, artist game.Artist,
 	bidder game.Player, value int) *game.Auction {
-	artPiece := newArtPiece(artist)
-	return game.NewAuction(auctioneer, &artPiece, game.NewBid(bidder, value))
+	return game.NewAuction(auctioneer, newArtPiece(artist), game.NewBid(bidder, value))
+}
+
+func newDoubleAuctionWithWinningBid(auctioneer game.Player, artist game.Artist, auctionType game.AuctionType,
+	bidder game.Player, value int) *game.Auction {
+	auction := game.NewAuction(auctioneer, game.NewArtPieceWithAuctionType(artist, "double", game.AuctionTypeDouble),
+		game.NewBid(bidder, value))
+	auction.SecondArtPiece = game.NewArtPieceWithAuctionType(artist, "second", auctionType)
+	auction.Type = auctionType
+	return auction
+}
+
+// newDeck creates a deck of n ArtPieces by the artist with the given AuctionType
+func newDeck(artist game.Artist, auctionType game.AuctionType, n int) []*game.ArtPiece {
+	deck := make([]*game.ArtPiece, n, n)
+	for i := 0; i < n; i++ {
+		deck[i] = game.NewArtPieceWithAuctionType(artist, fmt.Sprintf("%s-%d", auctionType, i), auctionType)
+	}
+	return deck
 }
 
 // NewPhase creates a new phase with the given artist counts
@@ -56,3 +77,10 @@ func mustMatchArtPiece(suite *suite.Suite, a1, a2 *game.ArtPiece) {
 	suite.Equal(a1.Name, a2.Name)
 	suite.Equal(a1.Artist, a2.Artist)
 }
+
+// mustNewGame creates a Game and fails the test if the players or options are rejected
+func mustNewGame(suite *suite.Suite, ps []game.Player, opts ...game.GameOption) *game.Game {
+	ng, err := game.NewGame(ps, opts...)
+	suite.Require().NoError(err)
+	return ng
+}
@@ -1,11 +1,47 @@
 package game
 
 import (
-	"crypto/rand"
-	"math/big"
+	crand "crypto/rand"
+	"encoding/binary"
+	"math/rand"
 )
 
-func randInt(max int) (int, error) {
-	i, err := rand.Int(rand.Reader, big.NewInt(int64(max)))
-	return int(i.Int64()), err
+// randomSeed returns a seed from crypto/rand, used when the Game is not given one
+func randomSeed() int64 {
+	var b [8]byte
+	if _, err := crand.Read(b[:]); err != nil {
+		panic(err)
+	}
+	return int64(binary.LittleEndian.Uint64(b[:]))
+}
+
+// countingSource counts the values drawn from a seeded rand.Source, so that
+// its state can be restored from the seed and the number of draws
+type countingSource struct {
+	src   rand.Source64
+	draws uint64
+}
+
+// newCountingSource returns a source seeded with seed that has already drawn draws values
+func newCountingSource(seed int64, draws uint64) *countingSource {
+	s := &countingSource{src: rand.NewSource(seed).(rand.Source64)}
+	for s.draws < draws {
+		s.Int63()
+	}
+	return s
+}
+
+func (s *countingSource) Int63() int64 {
+	s.draws++
+	return s.src.Int63()
+}
+
+func (s *countingSource) Uint64() uint64 {
+	s.draws++
+	return s.src.Uint64()
+}
+
+func (s *countingSource) Seed(seed int64) {
+	s.src.Seed(seed)
+	s.draws = 0
 }
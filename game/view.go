@@ -0,0 +1,161 @@
+package game
+
+// GameView is the public information of a Game as seen by one Player, along with their own
+// hand and money. The RuleSet decides what is public: other players' money and the losing
+// bids of blind Auctions may be hidden. The Game passes a new GameView to every decision a Player makes.
+// It is a copy, so it does not change as the Game goes on and changing it does not change the Game.
+type GameView struct {
+	player string
+	hand   []*ArtPiece
+	money  int
+	// playerMoney is every player's money. It is nil if the rules keep money hidden.
+	playerMoney  map[string]int
+	currentPhase PhaseNumber
+	phase        *Phase
+	pastPhases   []*Phase
+	collections  map[string][]*ArtPiece
+	turnOrder    []string
+	rules        *RuleSet
+}
+
+// View returns the GameView of the named Player, or nil if there is no such Player
+func (g *Game) View(player string) *GameView {
+	gp := g.LookupGamePlayer(player)
+	if gp == nil {
+		return nil
+	}
+	return g.view(gp)
+}
+
+func (g *Game) view(player *GamePlayer) *GameView {
+	view := &GameView{
+		player:       player.Player.Name(),
+		hand:         copyArtPieces(player.Hand),
+		money:        player.Money,
+		currentPhase: g.CurrentPhase,
+		phase:        g.rules.NewPhase(),
+		pastPhases:   make([]*Phase, 0, len(g.PastPhases)),
+		collections:  make(map[string][]*ArtPiece),
+		turnOrder:    make([]string, 0, len(g.Players)),
+		rules:        g.rules,
+	}
+	if g.phase != nil {
+		view.phase = g.publicPhase(g.phase)
+	}
+	for _, phase := range g.PastPhases {
+		view.pastPhases = append(view.pastPhases, g.publicPhase(phase))
+	}
+	if g.rules.OpenMoney {
+		view.playerMoney = make(map[string]int)
+		for _, gp := range g.Players {
+			view.playerMoney[gp.Player.Name()] = gp.Money
+		}
+	}
+	for _, gp := range g.Players {
+		view.collections[gp.Player.Name()] = copyArtPieces(gp.Collection)
+		view.turnOrder = append(view.turnOrder, gp.Player.Name())
+	}
+	return view
+}
+
+// copyPhase copies the Phase and its Auctions
+func copyPhase(phase *Phase) *Phase {
+	newPhase := phase.Copy()
+	for i, auction := range newPhase.Auctions {
+		newPhase.Auctions[i] = copyAuction(auction)
+	}
+	return newPhase
+}
+
+// Player returns the name of the Player the GameView belongs to
+func (v *GameView) Player() string {
+	return v.player
+}
+
+// Hand returns the Player's hand
+func (v *GameView) Hand() []*ArtPiece {
+	return copyArtPieces(v.hand)
+}
+
+// Money returns the Player's money
+func (v *GameView) Money() int {
+	return v.money
+}
+
+// PlayerMoney returns the named player's money. It returns false for other players if the rules keep money hidden.
+func (v *GameView) PlayerMoney(player string) (int, bool) {
+	if player == v.player {
+		return v.money, true
+	}
+	money, ok := v.playerMoney[player]
+	return money, ok
+}
+
+// CurrentPhase returns the number of the Phase being played
+func (v *GameView) CurrentPhase() PhaseNumber {
+	return v.currentPhase
+}
+
+// Phase returns the Auctions started so far in the current Phase, including one being bid on
+func (v *GameView) Phase() *Phase {
+	return copyPhase(v.phase)
+}
+
+// PastPhases returns the Phases that are over
+func (v *GameView) PastPhases() []*Phase {
+	phases := make([]*Phase, 0, len(v.pastPhases))
+	for _, phase := range v.pastPhases {
+		phases = append(phases, copyPhase(phase))
+	}
+	return phases
+}
+
+// ArtistValues returns the value each artist has gained in past Phases. An ArtPiece by an artist
+// who places in the current Phase pays this value plus the payout for the artist's rank.
+func (v *GameView) ArtistValues() map[Artist]int {
+	values := make(map[Artist]int)
+	for _, artist := range AllArtists() {
+		values[artist] = 0
+	}
+	for _, phase := range v.pastPhases {
+		for artist, payout := range phase.PhasePayouts() {
+			values[artist] += payout
+		}
+	}
+	return values
+}
+
+// Collection returns the ArtPieces the named player bought in the current Phase
+func (v *GameView) Collection(player string) []*ArtPiece {
+	return copyArtPieces(v.collections[player])
+}
+
+// Collections returns the ArtPieces each player bought in the current Phase by player name
+func (v *GameView) Collections() map[string][]*ArtPiece {
+	collections := make(map[string][]*ArtPiece)
+	for player, collection := range v.collections {
+		collections[player] = copyArtPieces(collection)
+	}
+	return collections
+}
+
+// TurnOrder returns the players' names in the order they hold Auctions, starting with the
+// next auctioneer. While an Auction is held, its auctioneer is last, as they bid last.
+func (v *GameView) TurnOrder() []string {
+	return append([]string{}, v.turnOrder...)
+}
+
+// Position returns the Player's index in the TurnOrder
+func (v *GameView) Position() int {
+	for i, player := range v.turnOrder {
+		if player == v.player {
+			return i
+		}
+	}
+	return -1
+}
+
+// Rules returns a copy of the RuleSet the Game is played with
+func (v *GameView) Rules() *RuleSet {
+	return v.rules.Copy()
+}
@@ -0,0 +1,117 @@
+package game
+
+// ViewState is a GameView as plain data, so that it can be sent to Players outside the process.
+// Players in its Auctions are replaced by their names.
+type ViewState struct {
+	Player string      `json:"player"`
+	Hand   []*ArtPiece `json:"hand"`
+	Money  int         `json:"money"`
+	// PlayerMoney is every player's money. It is left out if the rules keep money hidden.
+	PlayerMoney  map[string]int         `json:"player_money,omitempty"`
+	CurrentPhase PhaseNumber            `json:"current_phase"`
+	Phase        *PhaseSnapshot         `json:"phase"`
+	PastPhases   []*PhaseSnapshot       `json:"past_phases"`
+	Collections  map[string][]*ArtPiece `json:"collections"`
+	TurnOrder    []string               `json:"turn_order"`
+	Rules        *RuleSet               `json:"rules"`
+}
+
+// State returns the GameView as plain data
+func (v *GameView) State() *ViewState {
+	state := &ViewState{
+		Player:       v.player,
+		Hand:         copyArtPieces(v.hand),
+		Money:        v.money,
+		CurrentPhase: v.currentPhase,
+		Phase:        newPhaseSnapshot(v.phase),
+		PastPhases:   make([]*PhaseSnapshot, 0, len(v.pastPhases)),
+		Collections:  v.Collections(),
+		TurnOrder:    v.TurnOrder(),
+		Rules:        v.Rules(),
+	}
+	if v.playerMoney != nil {
+		state.PlayerMoney = make(map[string]int)
+		for player, money := range v.playerMoney {
+			state.PlayerMoney[player] = money
+		}
+	}
+	for _, phase := range v.pastPhases {
+		state.PastPhases = append(state.PastPhases, newPhaseSnapshot(phase))
+	}
+	return state
+}
+
+// NewGameView rebuilds a GameView from its State, as a Player outside the process sees it.
+// The Players of its Auctions are known by name only. A State without Rules follows the official rules.
+func NewGameView(state *ViewState) *GameView {
+	rules := DefaultRuleSet()
+	if state.Rules != nil {
+		rules = state.Rules.Copy()
+	}
+	view := &GameView{
+		player:       state.Player,
+		hand:         copyArtPieces(state.Hand),
+		money:        state.Money,
+		currentPhase: state.CurrentPhase,
+		phase:        rules.NewPhase(),
+		pastPhases:   make([]*Phase, 0, len(state.PastPhases)),
+		collections:  make(map[string][]*ArtPiece),
+		turnOrder:    append([]string{}, state.TurnOrder...),
+		rules:        rules,
+	}
+	if state.PlayerMoney != nil {
+		view.playerMoney = make(map[string]int)
+		for player, money := range state.PlayerMoney {
+			view.playerMoney[player] = money
+		}
+	}
+	if state.Phase != nil {
+		view.phase = newPublicPhase(rules, state.Phase)
+	}
+	for _, phase := range state.PastPhases {
+		view.pastPhases = append(view.pastPhases, newPublicPhase(rules, phase))
+	}
+	for player, collection := range state.Collections {
+		view.collections[player] = copyArtPieces(collection)
+	}
+	return view
+}
+
+// newPublicPhase rebuilds a Phase from a PhaseSnapshot with its Players known by name only
+func newPublicPhase(rules *RuleSet, snapshot *PhaseSnapshot) *Phase {
+	phase := rules.NewPhase()
+	for artist, count := range snapshot.ArtistCounts {
+		phase.ArtistCounts[artist] = count
+	}
+	for _, auction := range snapshot.Auctions {
+		phase.Auctions = append(phase.Auctions, auction.PublicAuction())
+	}
+	return phase
+}
+
+// PublicAuction rebuilds the Auction with its Players known by name only, as a Player outside the process sees it
+func (s *AuctionSnapshot) PublicAuction() *Auction {
+	auction := &Auction{
+		Auctioneer:     &publicPlayer{name: s.Auctioneer},
+		Type:           s.Type,
+		ArtPiece:       s.ArtPiece,
+		SecondArtPiece: s.SecondArtPiece,
+		WinningBid:     s.WinningBid.publicBid(),
+	}
+	for _, bid := range s.BlindBids {
+		auction.BlindBids = append(auction.BlindBids, bid.publicBid())
+	}
+	return auction
+}
+
+// publicBid rebuilds the Bid with its Bidder known by name only
+func (s *BidSnapshot) publicBid() *Bid {
+	if s == nil {
+		return nil
+	}
+	var bidder Player
+	if s.Bidder != "" {
+		bidder = &publicPlayer{name: s.Bidder}
+	}
+	return NewBid(bidder, s.Value)
+}
@@ -0,0 +1,268 @@
+package game_test
+
+import (
+	"context"
+	"fmt"
+	"github.com/SachinMeier/modern-art.git/game"
+	"github.com/SachinMeier/modern-art.git/game/players"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+func TestGameViewSuite(t *testing.T) {
+	suite.Run(t, new(GameViewTestSuite))
+}
+
+type GameViewTestSuite struct {
+	suite.Suite
+	testCtx    context.Context
+	cancelFunc context.CancelFunc
+}
+
+func (suite *GameViewTestSuite) SetupSuite() {}
+
+func (suite *GameViewTestSuite) SetupTest() {
+	suite.testCtx, suite.cancelFunc = context.WithCancel(context.Background())
+}
+
+func (suite *GameViewTestSuite) TearDownTest() {
+	suite.cancelFunc()
+}
+
+func (suite *GameViewTestSuite) TearDownSuite() {}
+
+func (suite *GameViewTestSuite) Test_View() {
+	// 1. Test that the view holds the player's hand and money and every collection
+	{
+		ng := suite.newGame(suite.getNDummyPlayers(4))
+		for i := 0; i < 4; i++ {
+			_, err := ng.DoTurn()
+			suite.Require().NoError(err)
+		}
+
+		view := ng.View("dummy-1")
+		gp := ng.LookupGamePlayer("dummy-1")
+		suite.Equal("dummy-1", view.Player())
+		suite.Equal(handNames(gp), handNames(&game.GamePlayer{Hand: view.Hand()}))
+		suite.Equal(gp.Money, view.Money())
+		suite.Equal(game.Phase1, view.CurrentPhase())
+		suite.Equal(4, view.Phase().Len())
+		for _, player := range ng.Players {
+			suite.Equal(player.Collection, view.Collection(player.Player.Name()))
+			suite.Equal(player.Collection, view.Collections()[player.Player.Name()])
+		}
+	}
+
+	// 2. Test that the turn order starts with the next auctioneer
+	{
+		ng := suite.newGame(suite.getNDummyPlayers(4))
+		_, err := ng.DoTurn()
+		suite.Require().NoError(err)
+
+		view := ng.View("dummy-2")
+		suite.Equal([]string{"dummy-1", "dummy-2", "dummy-3", "dummy-0"}, view.TurnOrder())
+		suite.Equal(1, view.Position())
+	}
+
+	// 3. Test that artist values add up the payouts of past phases
+	{
+		ng := suite.newGame(suite.getNDummyPlayers(4))
+		_, err := ng.DoPhase()
+		suite.Require().NoError(err)
+
+		view := ng.View("dummy-0")
+		suite.Equal(0, view.Phase().Len())
+		suite.Equal(1, len(view.PastPhases()))
+		payouts := ng.PastPhases[0].PhasePayouts()
+		for _, artist := range game.AllArtists() {
+			suite.Equal(payouts[artist], view.ArtistValues()[artist])
+		}
+	}
+
+	// 4. Test that changing the view does not change the Game
+	{
+		ng := suite.newGame(suite.getNDummyPlayers(4))
+		for i := 0; i < 2; i++ {
+			_, err := ng.DoTurn()
+			suite.Require().NoError(err)
+		}
+		hand := handNames(ng.LookupGamePlayer("dummy-0"))
+
+		view := ng.View("dummy-0")
+		view.Hand()[0] = nil
+		view.Phase().Auctions[0].WinningBid.Value = -1
+		view.Phase().ArtistCounts[game.Manuel] = 100
+		suite.Equal(hand, handNames(ng.LookupGamePlayer("dummy-0")))
+		suite.NotNil(view.Hand()[0])
+		suite.NotEqual(-1, view.Phase().Auctions[0].WinningBid.Value)
+		suite.NotEqual(100, view.Phase().ArtistCounts[game.Manuel])
+	}
+
+	// 5. Test that an unknown player has no view
+	{
+		ng := suite.newGame(suite.getNDummyPlayers(4))
+		suite.Nil(ng.View("nobody"))
+	}
+}
+
+func (suite *GameViewTestSuite) Test_DecisionView() {
+	// 1. Test that bidders see the Auction they bid on and the auctioneer bids last
+	{
+		recorder := &viewRecorder{DummyPlayer: players.NewDummyPlayer("recorder")}
+		ng := suite.newGame(append(suite.getNDummyPlayers(3), recorder))
+		_, err := ng.DoTurn()
+		suite.Require().NoError(err)
+
+		suite.Require().Equal(1, len(recorder.views))
+		view := recorder.views[0]
+		suite.Equal(1, view.Phase().Len())
+		suite.Equal([]string{"dummy-1", "dummy-2", "recorder", "dummy-0"}, view.TurnOrder())
+		suite.Equal(2, view.Position())
+		auction := view.Phase().Auctions[0]
+		suite.Equal("dummy-0", auction.Auctioneer.Name())
+		// the view is not updated as the Game goes on
+		suite.Equal(officialRules.StartingMoney, view.Money())
+		suite.Equal(0, len(view.Collection(auction.Auctioneer.Name())))
+	}
+}
+
+func (suite *GameViewTestSuite) Test_Information() {
+	// 1. Test that players only see their own money by default
+	{
+		ng := suite.newGame(suite.getNDummyPlayers(4))
+		_, err := ng.DoTurn()
+		suite.Require().NoError(err)
+
+		view := ng.View("dummy-1")
+		money, ok := view.PlayerMoney("dummy-1")
+		suite.True(ok)
+		suite.Equal(ng.LookupGamePlayer("dummy-1").Money, money)
+		_, ok = view.PlayerMoney("dummy-0")
+		suite.False(ok)
+	}
+
+	// 2. Test that players see everyone's money if the rules make it open
+	{
+		rules := officialRules.Copy()
+		rules.OpenMoney = true
+		ng := mustNewGame(&suite.Suite, suite.getNDummyPlayers(4), game.WithSeed(7), game.WithRuleSet(rules))
+		_, err := ng.DoTurn()
+		suite.Require().NoError(err)
+
+		view := ng.View("dummy-1")
+		for _, player := range ng.Players {
+			money, ok := view.PlayerMoney(player.Player.Name())
+			suite.True(ok)
+			suite.Equal(player.Money, money)
+		}
+	}
+
+	// 3. Test that the bids of a blind Auction are revealed once it is over, and only if the rules reveal them
+	{
+		for _, reveal := range []bool{true, false} {
+			rules := officialRules.Copy()
+			rules.RevealBlindBids = reveal
+			recorder := &viewRecorder{DummyPlayer: players.NewDummyPlayer("recorder")}
+			ng := mustNewGame(&suite.Suite, append(suite.getNDummyPlayers(3), recorder), game.WithSeed(7), game.WithRuleSet(rules))
+			ng.ArtPieces = newDeck(game.Manuel, game.AuctionTypeBlind, 40)
+			_, err := ng.DoTurn()
+			suite.Require().NoError(err)
+
+			// no one sees the other bids while bidding
+			suite.Require().Equal(1, len(recorder.views))
+			suite.Empty(recorder.views[0].Phase().Auctions[0].BlindBids)
+			suite.Empty(recorder.auctions[0].BlindBids)
+
+			auction := ng.View("dummy-1").Phase().Auctions[0]
+			suite.NotNil(auction.WinningBid)
+			if !reveal {
+				suite.Empty(auction.BlindBids)
+				continue
+			}
+			bidders := make([]string, 0)
+			for _, bid := range auction.BlindBids {
+				bidders = append(bidders, bid.Bidder.Name())
+			}
+			suite.Equal([]string{"dummy-1", "dummy-2", "recorder", "dummy-0"}, bidders)
+		}
+	}
+
+	// 4. Test that players cannot reach other Players through the Auctions they are shown
+	{
+		recorder := &viewRecorder{DummyPlayer: players.NewDummyPlayer("recorder")}
+		ng := suite.newGame(append(suite.getNDummyPlayers(3), recorder))
+		for i := 0; i < 4; i++ {
+			_, err := ng.DoTurn()
+			suite.Require().NoError(err)
+		}
+
+		suite.Require().NotEmpty(recorder.results)
+		auctions := append(ng.View("recorder").Phase().Auctions, recorder.auctions...)
+		auctions = append(auctions, recorder.results...)
+		for _, auction := range auctions {
+			_, ok := auction.Auctioneer.(*players.DummyPlayer)
+			suite.False(ok)
+			if auction.WinningBid != nil {
+				_, ok = auction.WinningBid.Bidder.(*players.DummyPlayer)
+				suite.False(ok)
+			}
+		}
+	}
+
+	// 5. Test that a view rebuilt from its State shows the same Game
+	{
+		rules := officialRules.Copy()
+		rules.OpenMoney = true
+		ng := mustNewGame(&suite.Suite, suite.getNDummyPlayers(4), game.WithSeed(7), game.WithRuleSet(rules))
+		for i := 0; i < 6; i++ {
+			_, err := ng.DoTurn()
+			suite.Require().NoError(err)
+		}
+
+		view := ng.View("dummy-1")
+		rebuilt := game.NewGameView(view.State())
+		suite.Equal(view.State(), rebuilt.State())
+		suite.Equal(view.Hand(), rebuilt.Hand())
+		suite.Equal(view.Money(), rebuilt.Money())
+		suite.Equal(view.TurnOrder(), rebuilt.TurnOrder())
+		suite.Equal(view.Phase().ArtistCounts, rebuilt.Phase().ArtistCounts)
+		suite.Equal(len(view.Phase().Auctions), len(rebuilt.Phase().Auctions))
+		for i, auction := range view.Phase().Auctions {
+			suite.Equal(auction.Auctioneer.Name(), rebuilt.Phase().Auctions[i].Auctioneer.Name())
+		}
+	}
+}
+
+// helpers
+
+// viewRecorder records the views and Auctions it is given to bid and the results it is told about
+type viewRecorder struct {
+	*players.DummyPlayer
+	views    []*game.GameView
+	auctions []*game.Auction
+	results  []*game.Auction
+}
+
+func (p *viewRecorder) Bid(view *game.GameView, auction *game.Auction) (*game.Bid, error) {
+	p.views = append(p.views, view)
+	p.auctions = append(p.auctions, auction)
+	return p.DummyPlayer.Bid(view, auction)
+}
+
+func (p *viewRecorder) HandleAuctionResult(auction *game.Auction) {
+	p.results = append(p.results, auction)
+	p.DummyPlayer.HandleAuctionResult(auction)
+}
+
+// newGame creates a seeded Game
+func (suite *GameViewTestSuite) newGame(ps []game.Player) *game.Game {
+	return mustNewGame(&suite.Suite, ps, game.WithSeed(7))
+}
+
+func (suite *GameViewTestSuite) getNDummyPlayers(n int) []game.Player {
+	ps := make([]game.Player, n)
+	for i := 0; i < n; i++ {
+		ps[i] = players.NewDummyPlayer(fmt.Sprintf("dummy-%d", i))
+	}
+	return ps
+}